@@ -0,0 +1,140 @@
+package transport
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/marcodali/forbidden-memories-duel-online/pkg/models"
+	pb "github.com/marcodali/forbidden-memories-duel-online/pkg/proto"
+)
+
+func toProtoGame(view *models.GameView) *pb.Game {
+	game := &pb.Game{
+		Id:          view.ID,
+		State:       string(view.State),
+		PlayerIndex: int32(view.PlayerIndex),
+		Turn:        &pb.Turn{PlayerIndex: int32(view.Turn.PlayerIndex), Phase: string(view.Turn.Phase)},
+		Board:       &pb.Board{Terrain: string(view.Board.Terrain)},
+	}
+	for playerIndex, player := range view.Players {
+		game.Players = append(game.Players, &pb.Player{
+			Username:              player.Username,
+			LifePoints:            int32(player.LifePoints),
+			RemainingTurnsToAtack: int32(player.RemainingTurnsToAtack),
+			RemainingCount:        int32(player.RemainingCount),
+			HandSize:              int32(player.HandSize),
+			Hand:                  toProtoCards(player.Hand),
+			DestroyedCards:        toProtoCards(player.DestroyedCards),
+		})
+
+		board := &pb.PlayerBoard{FieldZone: toProtoCardState(view.Board.FieldZone[playerIndex], 0)}
+		for position := range 5 {
+			if card := view.Board.MonsterZones[playerIndex][position]; card != nil {
+				board.MonsterZone = append(board.MonsterZone, toProtoCardState(card, position))
+			}
+			if card := view.Board.MagicTrapZones[playerIndex][position]; card != nil {
+				board.MagicTrapZone = append(board.MagicTrapZone, toProtoCardState(card, position))
+			}
+		}
+		game.Board.Players = append(game.Board.Players, board)
+	}
+	return game
+}
+
+func toProtoCards(cards []models.CardView) []*pb.Card {
+	messages := []*pb.Card{}
+	for _, card := range cards {
+		messages = append(messages, toProtoCard(card))
+	}
+	return messages
+}
+
+func toProtoCard(card models.CardView) *pb.Card {
+	return &pb.Card{
+		Hidden:         card.Hidden,
+		TemplateId:     int32(card.TemplateID),
+		Name:           card.Name,
+		IsInAttackMode: card.IsInAttackMode,
+		CurrentAttack:  int32(card.CurrentAttack),
+		CurrentDefense: int32(card.CurrentDefense),
+	}
+}
+
+func toProtoCardState(card *models.CardView, position int) *pb.CardState {
+	if card == nil {
+		return nil
+	}
+	return &pb.CardState{
+		Card:          toProtoCard(*card),
+		FaceUp:        card.FaceUp,
+		IndexPosition: int32(position),
+		GuardianStar:  string(card.GuardianStar),
+	}
+}
+
+// the payload goes as JSON so new event types do not need new messages
+func toProtoEvent(event *models.Event) (*pb.Event, error) {
+	payload, err := json.Marshal(event.Payload)
+	if err != nil {
+		return nil, err
+	}
+	message := &pb.Event{
+		Type:      string(event.Type),
+		Timestamp: timestamppb.New(event.Timestamp),
+		Status:    string(event.Status),
+		Payload:   payload,
+	}
+	if event.Err != nil {
+		message.Error = event.Err.Error()
+	}
+	return message, nil
+}
+
+func toAction(request *pb.SubmitActionRequest) (models.Action, error) {
+	playerIndex := int(request.PlayerIndex)
+	switch action := request.Action.(type) {
+	case *pb.SubmitActionRequest_NextPhase:
+		return &models.NextPhaseAction{PlayerIndex: playerIndex}, nil
+	case *pb.SubmitActionRequest_NextTurn:
+		return &models.NextTurnAction{PlayerIndex: playerIndex}, nil
+	case *pb.SubmitActionRequest_PlaceCard:
+		handIndexes := []int{}
+		for _, handIndex := range action.PlaceCard.HandIndexes {
+			handIndexes = append(handIndexes, int(handIndex))
+		}
+		return &models.PlaceCardAction{
+			PlayerIndex:    playerIndex,
+			HandIndexes:    handIndexes,
+			Position:       int(action.PlaceCard.Position),
+			IsInAttackMode: action.PlaceCard.IsInAttackMode,
+			GuardianStar:   models.GuardianStar(action.PlaceCard.GuardianStar),
+		}, nil
+	case *pb.SubmitActionRequest_ChangePosition:
+		return &models.ChangePositionAction{PlayerIndex: playerIndex, Position: int(action.ChangePosition.Position)}, nil
+	case *pb.SubmitActionRequest_Attack:
+		return &models.AttackAction{
+			PlayerIndex:      playerIndex,
+			AttackerPosition: int(action.Attack.AttackerPosition),
+			DefenderPosition: int(action.Attack.DefenderPosition),
+		}, nil
+	case *pb.SubmitActionRequest_ActivateMagicCard:
+		return &models.ActivateMagicCardAction{PlayerIndex: playerIndex, CardID: int(action.ActivateMagicCard.CardId)}, nil
+	case *pb.SubmitActionRequest_ActivateFieldCard:
+		return &models.ActivateFieldCardAction{PlayerIndex: playerIndex, CardID: int(action.ActivateFieldCard.CardId)}, nil
+	case *pb.SubmitActionRequest_ActivateRitual:
+		return &models.ActivateRitualAction{
+			PlayerIndex: playerIndex,
+			CardID:      int(action.ActivateRitual.CardId),
+			Position:    int(action.ActivateRitual.Position),
+		}, nil
+	case *pb.SubmitActionRequest_EquipCard:
+		return &models.EquipCardAction{
+			PlayerIndex: playerIndex,
+			CardID:      int(action.EquipCard.CardId),
+			Position:    int(action.EquipCard.Position),
+		}, nil
+	}
+	return nil, fmt.Errorf("invalid action %T", request.Action)
+}
@@ -0,0 +1,358 @@
+package transport
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/marcodali/forbidden-memories-duel-online/pkg/models"
+	pb "github.com/marcodali/forbidden-memories-duel-online/pkg/proto"
+)
+
+// events waiting to be sent to a slow client, once full the client is disconnected
+const subscriberBuffer = 64
+
+type subscriber struct {
+	playerIndex int
+	events      chan *models.Event
+}
+
+// serves the GameEngineService on top of the engine, which only keeps the games in progress
+type Server struct {
+	pb.UnimplementedGameEngineServiceServer
+	engine      *models.Engine
+	pending     map[string]*models.Game // created games waiting to be started
+	subscribers map[string][]*subscriber
+	actions     map[string]*sync.Mutex // one action at a time per game
+	tokens      map[string][2]string   // secret of each player of the game, issued when it is created
+	mutex       sync.Mutex
+}
+
+func NewServer(engine *models.Engine) *Server {
+	return &Server{
+		engine:      engine,
+		pending:     make(map[string]*models.Game),
+		subscribers: make(map[string][]*subscriber),
+		actions:     make(map[string]*sync.Mutex),
+		tokens:      make(map[string][2]string),
+	}
+}
+
+func (s *Server) CreateGame(ctx context.Context, request *pb.CreateGameRequest) (*pb.CreateGameResponse, error) {
+	if len(request.Decks) != 2 {
+		return nil, status.Errorf(codes.InvalidArgument, "a game needs 2 decks, got %d", len(request.Decks))
+	}
+
+	decks := [2]*models.Deck{}
+	for playerIndex, deckRequest := range request.Decks {
+		deck, err := newDeck(deckRequest)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		decks[playerIndex] = deck
+	}
+	game, err := models.NewGame(decks)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	tokens, err := s.register(game)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.pending[game.ID] = game
+	return &pb.CreateGameResponse{GameId: game.ID, PlayerTokens: tokens[:]}, nil
+}
+
+// creates and starts the game of two decks paired by the matchmaker, the game is served and
+// archived as the ones created through CreateGame
+func (s *Server) StartMatchedGame(decks [2]*models.Deck) (*models.Game, [2]string, error) {
+	game, err := models.NewGame(decks)
+	if err != nil {
+		return nil, [2]string{}, err
+	}
+	tokens, err := s.register(game)
+	if err != nil {
+		return nil, [2]string{}, err
+	}
+	if err := game.Start(); err != nil {
+		s.forget(game)
+		return nil, [2]string{}, err
+	}
+	if err := s.engine.AddGame(game); err != nil {
+		s.forget(game)
+		return nil, [2]string{}, err
+	}
+	return game, tokens, nil
+}
+
+// issues the tokens of the players and broadcasts the events of the game, which is removed
+// from the engine once someone wins
+func (s *Server) register(game *models.Game) ([2]string, error) {
+	tokens := [2]string{}
+	for playerIndex := range tokens {
+		token, err := newPlayerToken()
+		if err != nil {
+			return [2]string{}, err
+		}
+		tokens[playerIndex] = token
+	}
+	game.OnEventProcessed(func(result models.EventResult) {
+		s.broadcast(game, result.Event)
+		if result.Event.Type == models.EventPlayerWins {
+			s.removeGame(game)
+		}
+	})
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.actions[game.ID] = &sync.Mutex{}
+	s.tokens[game.ID] = tokens
+	return tokens, nil
+}
+
+// a random secret only the player it was issued to knows
+func newPlayerToken() (string, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
+
+// checks that the request was made by the player it claims to come from
+func (s *Server) authorize(gameID string, playerIndex int32, token string) error {
+	if playerIndex < 0 || playerIndex > 1 {
+		return status.Errorf(codes.InvalidArgument, "invalid playerIndex %d: expected 0 or 1", playerIndex)
+	}
+	s.mutex.Lock()
+	tokens, exists := s.tokens[gameID]
+	s.mutex.Unlock()
+	if !exists {
+		return status.Errorf(codes.NotFound, "game %q not found", gameID)
+	}
+	if subtle.ConstantTimeCompare([]byte(tokens[playerIndex]), []byte(token)) != 1 {
+		return status.Errorf(codes.Unauthenticated, "invalid token for player %d", playerIndex)
+	}
+	return nil
+}
+
+func newDeck(request *pb.Deck) (*models.Deck, error) {
+	player, err := models.NewPlayer(request.Username)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.CardIds) != 40 {
+		return nil, fmt.Errorf("the deck of %s must have 40 cards, got %d", request.Username, len(request.CardIds))
+	}
+	cards := [40]*models.CardInstance{}
+	for index, cardID := range request.CardIds {
+		card, err := models.NewCardInstance(int(cardID))
+		if err != nil {
+			return nil, err
+		}
+		cards[index] = card
+	}
+	return models.NewDeck(player, cards)
+}
+
+func (s *Server) StartGame(ctx context.Context, request *pb.StartGameRequest) (*pb.StartGameResponse, error) {
+	s.mutex.Lock()
+	game, exists := s.pending[request.GameId]
+	delete(s.pending, request.GameId)
+	s.mutex.Unlock()
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "game %q is not waiting to be started", request.GameId)
+	}
+
+	if err := game.Start(); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err := s.engine.AddGame(game); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.StartGameResponse{}, nil
+}
+
+// applies the action of the player in turn, illegal moves are rejected
+func (s *Server) SubmitAction(ctx context.Context, request *pb.SubmitActionRequest) (*pb.SubmitActionResponse, error) {
+	game, err := s.engine.GetActiveGame(request.GameId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "game %q is not in progress", request.GameId)
+	}
+	if err := s.authorize(game.ID, request.PlayerIndex, request.PlayerToken); err != nil {
+		return nil, err
+	}
+	s.mutex.Lock()
+	action, exists := s.actions[game.ID]
+	s.mutex.Unlock()
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "game %q is not in progress", request.GameId)
+	}
+	action.Lock()
+	defer action.Unlock()
+
+	playerIndex := int(request.PlayerIndex)
+	if playerIndex != game.GetCurrentPlayerIndex() {
+		return nil, status.Errorf(codes.PermissionDenied, "it is not the turn of player %d", playerIndex)
+	}
+	move, err := toAction(request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := game.Apply(move); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	view, err := game.ViewFor(playerIndex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &pb.SubmitActionResponse{Game: toProtoGame(view)}, nil
+}
+
+// sends the events of the game as the player sees them until the game is over
+func (s *Server) StreamEvents(request *pb.StreamEventsRequest, stream pb.GameEngineService_StreamEventsServer) error {
+	if _, err := s.game(request.GameId); err != nil {
+		return err
+	}
+	if err := s.authorize(request.GameId, request.PlayerIndex, request.PlayerToken); err != nil {
+		return err
+	}
+
+	subscriber := s.subscribe(request.GameId, int(request.PlayerIndex))
+	defer s.unsubscribe(request.GameId, subscriber)
+	// the headers tell the client that no event will be missed from now on
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case event, open := <-subscriber.events:
+			if !open {
+				return status.Error(codes.ResourceExhausted, "the client could not keep up with the events")
+			}
+			if event == nil {
+				return nil // the game is over
+			}
+			message, err := toProtoEvent(event)
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			if err := stream.Send(message); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+func (s *Server) GetGameView(ctx context.Context, request *pb.GetGameViewRequest) (*pb.Game, error) {
+	game, err := s.game(request.GameId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(game.ID, request.PlayerIndex, request.PlayerToken); err != nil {
+		return nil, err
+	}
+	view, err := game.ViewFor(int(request.PlayerIndex))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return toProtoGame(view), nil
+}
+
+// looks for the game among the pending ones and then in the engine
+func (s *Server) game(gameID string) (*models.Game, error) {
+	s.mutex.Lock()
+	game, exists := s.pending[gameID]
+	s.mutex.Unlock()
+	if exists {
+		return game, nil
+	}
+	game, err := s.engine.GetActiveGame(gameID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "game %q not found", gameID)
+	}
+	return game, nil
+}
+
+func (s *Server) subscribe(gameID string, playerIndex int) *subscriber {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	subscriber := &subscriber{playerIndex: playerIndex, events: make(chan *models.Event, subscriberBuffer)}
+	s.subscribers[gameID] = append(s.subscribers[gameID], subscriber)
+	return subscriber
+}
+
+func (s *Server) unsubscribe(gameID string, subscriber *subscriber) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	subscribers := s.subscribers[gameID]
+	for index, current := range subscribers {
+		if current == subscriber {
+			s.subscribers[gameID] = append(subscribers[:index], subscribers[index+1:]...)
+			return
+		}
+	}
+}
+
+// runs in the event processing goroutine of the game, a nil event sent after the winner
+// tells the game is over
+func (s *Server) broadcast(game *models.Game, event *models.Event) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	kept := []*subscriber{}
+	for _, subscriber := range s.subscribers[game.ID] {
+		if !send(subscriber, event.ViewFor(subscriber.playerIndex)) {
+			close(subscriber.events)
+			continue
+		}
+		if event.Type == models.EventPlayerWins {
+			if !send(subscriber, nil) {
+				close(subscriber.events)
+			}
+			continue
+		}
+		kept = append(kept, subscriber)
+	}
+	s.subscribers[game.ID] = kept
+}
+
+// takes the finished game out of the engine, which archives it, and forgets about it,
+// a game that could not be archived stays in the engine
+func (s *Server) removeGame(game *models.Game) {
+	if err := s.engine.RemoveGame(game.ID); err != nil {
+		fmt.Printf("cannot remove game %s: %v\n", game.ID, err)
+		return
+	}
+	s.forget(game)
+}
+
+func (s *Server) forget(game *models.Game) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.actions, game.ID)
+	delete(s.tokens, game.ID)
+	delete(s.subscribers, game.ID)
+}
+
+func send(subscriber *subscriber, event *models.Event) bool {
+	select {
+	case subscriber.events <- event:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,256 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/marcodali/forbidden-memories-duel-online/pkg/models"
+	pb "github.com/marcodali/forbidden-memories-duel-online/pkg/proto"
+	"github.com/marcodali/forbidden-memories-duel-online/pkg/utils"
+)
+
+const (
+	PLAYER_A = 0
+	PLAYER_B = 1
+)
+
+// serves the GameEngineService in memory and returns a client connected to it
+func newTestClient(t *testing.T) (pb.GameEngineServiceClient, *Server) {
+	_, err := utils.LoadDefaultRegistry()
+	assert.NoError(t, err)
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := NewServer(models.NewEngine())
+	grpcServer := grpc.NewServer()
+	pb.RegisterGameEngineServiceServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	connection, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	t.Cleanup(func() { connection.Close() })
+	return pb.NewGameEngineServiceClient(connection), server
+}
+
+func newDeckRequest(username string) *pb.Deck {
+	deck := &pb.Deck{Username: username}
+	for cardID := range 40 {
+		deck.CardIds = append(deck.CardIds, int32(cardID+2))
+	}
+	return deck
+}
+
+func assertCode(t *testing.T, expected codes.Code, err error) {
+	assert.Error(t, err)
+	assert.Equal(t, expected, status.Code(err))
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+type archiveFunc func(game *models.Game) error
+
+func (f archiveFunc) ArchiveGame(game *models.Game) error { return f(game) }
+
+func submit(client pb.GameEngineServiceClient, game *pb.CreateGameResponse, playerIndex int32, action *pb.SubmitActionRequest) (*pb.SubmitActionResponse, error) {
+	action.GameId = game.GameId
+	action.PlayerIndex = playerIndex
+	action.PlayerToken = game.PlayerTokens[playerIndex]
+	return client.SubmitAction(context.Background(), action)
+}
+
+func TestGameEngineService(t *testing.T) {
+	client, server := newTestClient(t)
+	ctx := context.Background()
+
+	_, err := client.CreateGame(ctx, &pb.CreateGameRequest{Decks: []*pb.Deck{newDeckRequest("PlayerA")}})
+	assertCode(t, codes.InvalidArgument, err)
+
+	created, err := client.CreateGame(ctx, &pb.CreateGameRequest{Decks: []*pb.Deck{newDeckRequest("PlayerA"), newDeckRequest("PlayerB")}})
+	assert.NoError(t, err)
+	gameID := created.GameId
+
+	assert.Len(t, created.PlayerTokens, 2)
+	assert.NotEqual(t, created.PlayerTokens[PLAYER_A], created.PlayerTokens[PLAYER_B])
+	view, err := client.GetGameView(ctx, &pb.GetGameViewRequest{GameId: gameID, PlayerIndex: PLAYER_A, PlayerToken: created.PlayerTokens[PLAYER_A]})
+	assert.NoError(t, err)
+	assert.Equal(t, string(models.GameReadyToStart), view.State)
+
+	// the stream is ready once its headers arrive, so the shuffles are not missed
+	stream, err := client.StreamEvents(ctx, &pb.StreamEventsRequest{GameId: gameID, PlayerIndex: PLAYER_B, PlayerToken: created.PlayerTokens[PLAYER_B]})
+	assert.NoError(t, err)
+	_, err = stream.Header()
+	assert.NoError(t, err)
+
+	_, err = client.StartGame(ctx, &pb.StartGameRequest{GameId: gameID})
+	assert.NoError(t, err)
+	_, err = client.StartGame(ctx, &pb.StartGameRequest{GameId: gameID})
+	assertCode(t, codes.NotFound, err)
+
+	for range 2 {
+		event, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, string(models.EventDeckShuffled), event.Type)
+		assert.Equal(t, string(models.SOECompleted), event.Status)
+	}
+	event, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, string(models.EventCardsDrawn), event.Type)
+	drawn := &models.CardsDrawnPayload{}
+	assert.NoError(t, json.Unmarshal(event.Payload, drawn))
+	assert.Nil(t, drawn.CardIDs, "the cards drawn by the opponent are hidden")
+
+	// the token of a player does not let anybody else act or look on its behalf
+	_, err = client.SubmitAction(ctx, &pb.SubmitActionRequest{GameId: gameID, PlayerIndex: PLAYER_A, PlayerToken: created.PlayerTokens[PLAYER_B], Action: &pb.SubmitActionRequest_NextPhase{NextPhase: &pb.NextPhaseAction{}}})
+	assertCode(t, codes.Unauthenticated, err)
+	_, err = client.GetGameView(ctx, &pb.GetGameViewRequest{GameId: gameID, PlayerIndex: PLAYER_A})
+	assertCode(t, codes.Unauthenticated, err)
+	spy, err := client.StreamEvents(ctx, &pb.StreamEventsRequest{GameId: gameID, PlayerIndex: PLAYER_A, PlayerToken: created.PlayerTokens[PLAYER_B]})
+	assert.NoError(t, err)
+	_, err = spy.Recv()
+	assertCode(t, codes.Unauthenticated, err)
+
+	// only the player in turn can act and illegal moves are rejected
+	_, err = submit(client, created, PLAYER_B, &pb.SubmitActionRequest{Action: &pb.SubmitActionRequest_NextPhase{NextPhase: &pb.NextPhaseAction{}}})
+	assertCode(t, codes.PermissionDenied, err)
+	_, err = submit(client, created, PLAYER_A, &pb.SubmitActionRequest{Action: &pb.SubmitActionRequest_Attack{Attack: &pb.AttackAction{DefenderPosition: -1}}})
+	assertCode(t, codes.FailedPrecondition, err)
+	_, err = submit(client, created, PLAYER_A, &pb.SubmitActionRequest{Action: &pb.SubmitActionRequest_ActivateMagicCard{ActivateMagicCard: &pb.ActivateMagicCardAction{CardId: 342}}})
+	assertCode(t, codes.FailedPrecondition, err)
+
+	phases := []models.TurnPhase{models.PlaceCardsPhase, models.ActionPhase, models.EndPhase}
+	for _, phase := range phases {
+		response, err := submit(client, created, PLAYER_A, &pb.SubmitActionRequest{Action: &pb.SubmitActionRequest_NextPhase{NextPhase: &pb.NextPhaseAction{}}})
+		assert.NoError(t, err)
+		assert.Equal(t, string(phase), response.Game.Turn.Phase)
+	}
+	response, err := submit(client, created, PLAYER_A, &pb.SubmitActionRequest{Action: &pb.SubmitActionRequest_NextTurn{NextTurn: &pb.NextTurnAction{}}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(PLAYER_B), response.Game.Turn.PlayerIndex)
+
+	for _, phase := range append(phases, models.DrawCardsPhase) {
+		event, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, string(models.EventTurnPhaseChange), event.Type)
+		payload := &models.TurnPhaseChangePayload{}
+		assert.NoError(t, json.Unmarshal(event.Payload, payload))
+		assert.Equal(t, phase, payload.Phase)
+	}
+	event, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, string(models.EventCardsDrawn), event.Type, "the events chained to the turn change are streamed too")
+	assert.NoError(t, json.Unmarshal(event.Payload, drawn))
+	assert.Len(t, drawn.CardIDs, 5)
+
+	view, err = client.GetGameView(ctx, &pb.GetGameViewRequest{GameId: gameID, PlayerIndex: PLAYER_B, PlayerToken: created.PlayerTokens[PLAYER_B]})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(PLAYER_B), view.PlayerIndex)
+	assert.Equal(t, int32(35), view.Players[PLAYER_A].RemainingCount)
+	assert.Equal(t, int32(8000), view.Players[PLAYER_A].LifePoints)
+	assert.Equal(t, string(models.TerrainNormal), view.Board.Terrain)
+
+	_, err = client.GetGameView(ctx, &pb.GetGameViewRequest{GameId: gameID, PlayerIndex: 2, PlayerToken: created.PlayerTokens[PLAYER_B]})
+	assertCode(t, codes.InvalidArgument, err)
+	_, err = client.GetGameView(ctx, &pb.GetGameViewRequest{GameId: "unknown"})
+	assertCode(t, codes.NotFound, err)
+
+	// the stream ends with the game, which is archived and forgotten
+	archived := []string{}
+	server.engine.SetArchive(archiveFunc(func(game *models.Game) error {
+		archived = append(archived, game.ID)
+		return nil
+	}))
+	game, _ := server.engine.GetActiveGame(gameID)
+	monster, _ := models.NewCardInstance(38)
+	monster.IsInAttackMode = true
+	game.Board.MonsterZones[PLAYER_B][0] = &models.CardState{Card: monster, FaceUp: true}
+	game.Decks[PLAYER_A].Player.LifePoints = 100
+	for range 2 {
+		_, err = submit(client, created, PLAYER_B, &pb.SubmitActionRequest{Action: &pb.SubmitActionRequest_NextPhase{NextPhase: &pb.NextPhaseAction{}}})
+		assert.NoError(t, err)
+	}
+	response, err = submit(client, created, PLAYER_B, &pb.SubmitActionRequest{Action: &pb.SubmitActionRequest_Attack{Attack: &pb.AttackAction{DefenderPosition: -1}}})
+	assert.NoError(t, err)
+	assert.Equal(t, string(models.GameFinished), response.Game.State)
+	assert.Equal(t, int32(0), response.Game.Players[PLAYER_A].LifePoints)
+
+	events := []string{}
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		events = append(events, event.Type)
+	}
+	assert.Equal(t, []string{
+		string(models.EventTurnPhaseChange),
+		string(models.EventTurnPhaseChange),
+		string(models.EventMonsterBattle),
+		string(models.EventDirectDamageToLifePoints),
+		string(models.EventPlayerLoses),
+		string(models.EventPlayerWins),
+	}, events)
+
+	assert.Equal(t, []string{gameID}, archived)
+	assert.Equal(t, 0, server.engine.GetActiveGamesCount())
+	_, err = client.GetGameView(ctx, &pb.GetGameViewRequest{GameId: gameID, PlayerIndex: PLAYER_A, PlayerToken: created.PlayerTokens[PLAYER_A]})
+	assertCode(t, codes.NotFound, err)
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	assert.Empty(t, server.actions)
+	assert.Empty(t, server.tokens)
+	assert.Empty(t, server.subscribers)
+}
+
+func TestStartMatchedGame(t *testing.T) {
+	client, server := newTestClient(t)
+	ctx := context.Background()
+	deckA, err := newDeck(newDeckRequest("PlayerA"))
+	assert.NoError(t, err)
+	deckB, err := newDeck(newDeckRequest("PlayerB"))
+	assert.NoError(t, err)
+
+	game, tokens, err := server.StartMatchedGame([2]*models.Deck{deckA, deckB})
+	assert.NoError(t, err)
+	active, err := server.engine.GetActiveGame(game.ID)
+	assert.NoError(t, err)
+	assert.Same(t, game, active)
+
+	// the matched game is played through the transport as the ones created by the clients
+	created := &pb.CreateGameResponse{GameId: game.ID, PlayerTokens: tokens[:]}
+	view, err := client.GetGameView(ctx, &pb.GetGameViewRequest{GameId: game.ID, PlayerIndex: PLAYER_B, PlayerToken: tokens[PLAYER_B]})
+	assert.NoError(t, err)
+	assert.Equal(t, string(models.GameInProgress), view.State)
+	response, err := submit(client, created, PLAYER_A, &pb.SubmitActionRequest{Action: &pb.SubmitActionRequest_NextPhase{NextPhase: &pb.NextPhaseAction{}}})
+	assert.NoError(t, err)
+	assert.Equal(t, string(models.PlaceCardsPhase), response.Game.Turn.Phase)
+	_, err = submit(client, created, PLAYER_B, &pb.SubmitActionRequest{Action: &pb.SubmitActionRequest_NextPhase{NextPhase: &pb.NextPhaseAction{}}})
+	assertCode(t, codes.PermissionDenied, err)
+
+	// nothing is kept of a game that cannot be started
+	_, _, err = server.StartMatchedGame([2]*models.Deck{deckA, nil})
+	assert.Error(t, err)
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	assert.Len(t, server.tokens, 1)
+	assert.Len(t, server.actions, 1)
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
@@ -0,0 +1,221 @@
+package matchmaking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/marcodali/forbidden-memories-duel-online/pkg/models"
+)
+
+type Config struct {
+	InitialWindow  int // rating difference accepted as soon as the ticket is queued
+	WindowGrowth   int // added to the window every GrowthInterval waited
+	GrowthInterval time.Duration
+	MaxWindow      int
+	RegionWait     time.Duration // players of other countries are only matched after waiting this long
+	TickInterval   time.Duration // upper bound of the time between two scans of the queue
+}
+
+func DefaultConfig() Config {
+	return Config{
+		InitialWindow:  100,
+		WindowGrowth:   50,
+		GrowthInterval: 5 * time.Second,
+		MaxWindow:      500,
+		RegionWait:     15 * time.Second,
+		TickInterval:   500 * time.Millisecond,
+	}
+}
+
+// outcome of a ticket, the game is already started and registered in the engine
+type Match struct {
+	Game        *models.Game
+	PlayerIndex int
+	PlayerToken string // authorizes the actions of the player on the game
+	Err         error
+}
+
+// creates, starts and serves the game of two paired decks, implemented by the transport server
+type GameStarter interface {
+	StartMatchedGame(decks [2]*models.Deck) (*models.Game, [2]string, error)
+}
+
+// a deck waiting for an opponent
+type Ticket struct {
+	ID         string
+	Deck       *models.Deck
+	EnqueuedAt time.Time
+	match      chan Match
+}
+
+// receives the match of the ticket, the channel is closed without a value if the ticket is cancelled
+func (t *Ticket) Match() <-chan Match {
+	return t.match
+}
+
+// pairs queued decks of players with close ratings, preferring players of the same country
+type Matchmaker struct {
+	games   GameStarter
+	config  Config
+	tickets []*Ticket // oldest first
+	wake    chan struct{}
+	now     func() time.Time
+	mutex   sync.Mutex
+}
+
+func NewMatchmaker(games GameStarter, config Config) *Matchmaker {
+	return &Matchmaker{
+		games:  games,
+		config: config,
+		wake:   make(chan struct{}, 1),
+		now:    time.Now,
+	}
+}
+
+// scans the queue on every tick and whenever a ticket is added, until the context is done
+func (m *Matchmaker) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.config.TickInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-m.wake:
+		}
+		m.matchTickets()
+	}
+}
+
+func (m *Matchmaker) Enqueue(deck *models.Deck) (*Ticket, error) {
+	if deck == nil || deck.Player == nil {
+		return nil, errors.New("a deck with its player must be provided")
+	}
+	if !deck.Player.IsOnline || deck.Player.IsDueling {
+		return nil, fmt.Errorf("player %s must be online and not dueling to be queued", deck.Player.Username)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for _, ticket := range m.tickets {
+		if ticket.Deck.Player.ID == deck.Player.ID {
+			return nil, fmt.Errorf("player %s is already queued", deck.Player.Username)
+		}
+	}
+	ticket := &Ticket{
+		ID:         uuid.New().String(),
+		Deck:       deck,
+		EnqueuedAt: m.now(),
+		match:      make(chan Match, 1),
+	}
+	m.tickets = append(m.tickets, ticket)
+
+	select {
+	case m.wake <- struct{}{}:
+	default: // a scan is already pending
+	}
+	return ticket, nil
+}
+
+func (m *Matchmaker) Cancel(ticketID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for index, ticket := range m.tickets {
+		if ticket.ID == ticketID {
+			m.tickets = append(m.tickets[:index], m.tickets[index+1:]...)
+			close(ticket.match)
+			return nil
+		}
+	}
+	return fmt.Errorf("ticket %q is not queued", ticketID)
+}
+
+func (m *Matchmaker) QueueLength() int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return len(m.tickets)
+}
+
+// the accepted rating difference widens with the time waited
+func (m *Matchmaker) window(ticket *Ticket, now time.Time) int {
+	growths := int(now.Sub(ticket.EnqueuedAt) / m.config.GrowthInterval)
+	return min(m.config.InitialWindow+growths*m.config.WindowGrowth, m.config.MaxWindow)
+}
+
+func (m *Matchmaker) compatible(a, b *Ticket, now time.Time) bool {
+	if ratingDistance(a, b) > min(m.window(a, now), m.window(b, now)) {
+		return false
+	}
+	if a.Deck.Player.Country == b.Deck.Player.Country {
+		return true
+	}
+	return now.Sub(a.EnqueuedAt) >= m.config.RegionWait && now.Sub(b.EnqueuedAt) >= m.config.RegionWait
+}
+
+// pairs the oldest tickets first, each with the compatible ticket of the closest rating,
+// the games are started once the queue is unlocked so starting them does not delay the queue
+func (m *Matchmaker) matchTickets() {
+	for _, pair := range m.pairTickets() {
+		m.startGame(pair[0], pair[1])
+	}
+}
+
+// takes the paired tickets out of the queue
+func (m *Matchmaker) pairTickets() [][2]*Ticket {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	now := m.now()
+	pairs := [][2]*Ticket{}
+	remaining := []*Ticket{}
+	matched := map[*Ticket]bool{}
+	for index, ticket := range m.tickets {
+		if matched[ticket] {
+			continue
+		}
+		var opponent *Ticket
+		for _, candidate := range m.tickets[index+1:] {
+			if matched[candidate] || !m.compatible(ticket, candidate, now) {
+				continue
+			}
+			if opponent == nil || ratingDistance(ticket, candidate) < ratingDistance(ticket, opponent) {
+				opponent = candidate
+			}
+		}
+		if opponent == nil {
+			remaining = append(remaining, ticket)
+			continue
+		}
+		matched[ticket] = true
+		matched[opponent] = true
+		pairs = append(pairs, [2]*Ticket{ticket, opponent})
+	}
+	m.tickets = remaining
+	return pairs
+}
+
+func ratingDistance(a, b *Ticket) int {
+	distance := a.Deck.Player.Rating - b.Deck.Player.Rating
+	if distance < 0 {
+		return -distance
+	}
+	return distance
+}
+
+// the oldest ticket plays first
+func (m *Matchmaker) startGame(first, second *Ticket) {
+	game, tokens, err := m.games.StartMatchedGame([2]*models.Deck{first.Deck, second.Deck})
+	if err != nil {
+		first.match <- Match{Err: err}
+		second.match <- Match{Err: err}
+		return
+	}
+	first.Deck.Player.IsDueling = true
+	second.Deck.Player.IsDueling = true
+	first.match <- Match{Game: game, PlayerIndex: 0, PlayerToken: tokens[0]}
+	second.match <- Match{Game: game, PlayerIndex: 1, PlayerToken: tokens[1]}
+}
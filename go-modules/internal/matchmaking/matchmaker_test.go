@@ -0,0 +1,213 @@
+package matchmaking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/marcodali/forbidden-memories-duel-online/internal/engine/transport"
+	"github.com/marcodali/forbidden-memories-duel-online/pkg/models"
+)
+
+// returns a matchmaker whose clock only moves when the test says so
+func newTestMatchmaker() (*Matchmaker, *time.Time) {
+	return newTestMatchmakerOf(transport.NewServer(models.NewEngine()))
+}
+
+func newTestMatchmakerOf(games GameStarter) (*Matchmaker, *time.Time) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	matchmaker := NewMatchmaker(games, DefaultConfig())
+	matchmaker.now = func() time.Time { return now }
+	return matchmaker, &now
+}
+
+type startGameFunc func(decks [2]*models.Deck) (*models.Game, [2]string, error)
+
+func (f startGameFunc) StartMatchedGame(decks [2]*models.Deck) (*models.Game, [2]string, error) {
+	return f(decks)
+}
+
+func newQueuedDeck(t *testing.T, matchmaker *Matchmaker, username, country string, rating int) *Ticket {
+	player, _ := models.NewPlayer(username)
+	assert.NoError(t, player.SetCountry(country))
+	player.Rating = rating
+	deck, _ := models.NewDeck(player, [40]*models.CardInstance{})
+	ticket, err := matchmaker.Enqueue(deck)
+	assert.NoError(t, err)
+	return ticket
+}
+
+func receive(t *testing.T, ticket *Ticket) Match {
+	select {
+	case match, ok := <-ticket.Match():
+		assert.True(t, ok)
+		return match
+	default:
+		t.Fatalf("ticket %s was not matched", ticket.Deck.Player.Username)
+		return Match{}
+	}
+}
+
+func assertQueued(t *testing.T, tickets ...*Ticket) {
+	for _, ticket := range tickets {
+		assert.Empty(t, ticket.Match(), "ticket %s should still be queued", ticket.Deck.Player.Username)
+	}
+}
+
+func TestMatchmakerPairsTheClosestRatings(t *testing.T) {
+	engine := models.NewEngine()
+	matchmaker, _ := newTestMatchmakerOf(transport.NewServer(engine))
+	first := newQueuedDeck(t, matchmaker, "First", models.Mexico, 1500)
+	far := newQueuedDeck(t, matchmaker, "Far", models.Mexico, 1900)
+	near := newQueuedDeck(t, matchmaker, "Near", models.Mexico, 1580)
+	nearest := newQueuedDeck(t, matchmaker, "Nearest", models.Mexico, 1540)
+
+	matchmaker.matchTickets()
+	assertQueued(t, far, near)
+	assert.Equal(t, 2, matchmaker.QueueLength())
+
+	match := receive(t, first)
+	assert.NoError(t, match.Err)
+	assert.Equal(t, 0, match.PlayerIndex)
+	assert.Equal(t, models.GameInProgress, match.Game.State)
+	opponentMatch := receive(t, nearest)
+	assert.Same(t, match.Game, opponentMatch.Game)
+	assert.Equal(t, 1, opponentMatch.PlayerIndex)
+	assert.True(t, nearest.Deck.Player.IsDueling)
+	assert.NotEmpty(t, match.PlayerToken)
+	assert.NotEqual(t, match.PlayerToken, opponentMatch.PlayerToken)
+
+	game, err := engine.GetActiveGame(match.Game.ID)
+	assert.NoError(t, err)
+	assert.Same(t, match.Game, game)
+}
+
+func TestMatchmakerStartsTheGamesWithTheQueueUnlocked(t *testing.T) {
+	server := transport.NewServer(models.NewEngine())
+	var matchmaker *Matchmaker
+	locked := []bool{}
+	matchmaker, _ = newTestMatchmakerOf(startGameFunc(func(decks [2]*models.Deck) (*models.Game, [2]string, error) {
+		unlocked := matchmaker.mutex.TryLock()
+		if unlocked {
+			matchmaker.mutex.Unlock()
+		}
+		locked = append(locked, !unlocked)
+		return server.StartMatchedGame(decks)
+	}))
+	first := newQueuedDeck(t, matchmaker, "First", models.Mexico, 1500)
+	second := newQueuedDeck(t, matchmaker, "Second", models.Mexico, 1500)
+	third := newQueuedDeck(t, matchmaker, "Third", models.Mexico, 1500)
+	fourth := newQueuedDeck(t, matchmaker, "Fourth", models.Mexico, 1500)
+
+	matchmaker.matchTickets()
+	assert.Equal(t, []bool{false, false}, locked)
+	assert.Same(t, receive(t, first).Game, receive(t, second).Game)
+	assert.Same(t, receive(t, third).Game, receive(t, fourth).Game)
+	assert.Zero(t, matchmaker.QueueLength())
+}
+
+func TestMatchmakerWidensTheRatingWindow(t *testing.T) {
+	matchmaker, now := newTestMatchmaker()
+	low := newQueuedDeck(t, matchmaker, "Low", models.Chile, 1500)
+	high := newQueuedDeck(t, matchmaker, "High", models.Chile, 1800)
+
+	matchmaker.matchTickets()
+	assertQueued(t, low, high)
+
+	// 100 + 3 growths of 50 are not enough yet
+	*now = now.Add(15 * time.Second)
+	matchmaker.matchTickets()
+	assertQueued(t, low, high)
+
+	*now = now.Add(25 * time.Second)
+	matchmaker.matchTickets()
+	assert.NoError(t, receive(t, low).Err)
+	assert.NoError(t, receive(t, high).Err)
+	assert.Zero(t, matchmaker.QueueLength())
+}
+
+func TestMatchmakerPrefersTheSameCountry(t *testing.T) {
+	matchmaker, now := newTestMatchmaker()
+	mexican := newQueuedDeck(t, matchmaker, "Mexican", models.Mexico, 1500)
+	american := newQueuedDeck(t, matchmaker, "American", models.USA, 1500)
+
+	matchmaker.matchTickets()
+	assertQueued(t, mexican, american)
+
+	// a late player of the same country is preferred over the one waiting abroad
+	colombian := newQueuedDeck(t, matchmaker, "Colombian", models.Colombia, 1500)
+	otherMexican := newQueuedDeck(t, matchmaker, "OtherMexican", models.Mexico, 1600)
+	matchmaker.matchTickets()
+	assert.Same(t, receive(t, mexican).Game, receive(t, otherMexican).Game)
+	assertQueued(t, american, colombian)
+
+	*now = now.Add(DefaultConfig().RegionWait)
+	matchmaker.matchTickets()
+	assert.Same(t, receive(t, american).Game, receive(t, colombian).Game)
+}
+
+func TestMatchmakerCancel(t *testing.T) {
+	matchmaker, _ := newTestMatchmaker()
+	ticket := newQueuedDeck(t, matchmaker, "Impatient", models.Peru, 1500)
+
+	assert.NoError(t, matchmaker.Cancel(ticket.ID))
+	_, ok := <-ticket.Match()
+	assert.False(t, ok, "cancelled tickets are closed without a match")
+	assert.Zero(t, matchmaker.QueueLength())
+
+	err := matchmaker.Cancel(ticket.ID)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is not queued")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+func TestMatchmakerEnqueueWithInvalidPlayer(t *testing.T) {
+	matchmaker, _ := newTestMatchmaker()
+	_, err := matchmaker.Enqueue(nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "a deck with its player must be provided")
+
+	ticket := newQueuedDeck(t, matchmaker, "Twice", models.Brazil, 1500)
+	_, err = matchmaker.Enqueue(ticket.Deck)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "player Twice is already queued")
+
+	player, _ := models.NewPlayer("Busy")
+	player.IsDueling = true
+	deck, _ := models.NewDeck(player, [40]*models.CardInstance{})
+	_, err = matchmaker.Enqueue(deck)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "must be online and not dueling")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+func TestMatchmakerRun(t *testing.T) {
+	matchmaker := NewMatchmaker(transport.NewServer(models.NewEngine()), DefaultConfig())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go matchmaker.Run(ctx)
+
+	playerA, _ := models.NewPlayer("PlayerA")
+	playerB, _ := models.NewPlayer("PlayerB")
+	deckA, _ := models.NewDeck(playerA, [40]*models.CardInstance{})
+	deckB, _ := models.NewDeck(playerB, [40]*models.CardInstance{})
+	ticketA, _ := matchmaker.Enqueue(deckA)
+	ticketB, _ := matchmaker.Enqueue(deckB)
+
+	// the queue is scanned as soon as a ticket arrives
+	for _, ticket := range []*Ticket{ticketA, ticketB} {
+		select {
+		case match := <-ticket.Match():
+			assert.NoError(t, match.Err)
+			assert.Equal(t, models.GameInProgress, match.Game.State)
+		case <-time.After(time.Second):
+			t.Fatal("the players were not matched in time")
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/marcodali/forbidden-memories-duel-online/pkg/models"
+)
+
+// returned by the repositories when the requested record does not exist
+var ErrNotFound = errors.New("record not found")
+
+// a finished duel, its event log is enough to replay it
+type GameRecord struct {
+	ID              string
+	PlayerIDs       [2]string
+	FinalLifePoints [2]int
+	WinnerIndex     int // models.NoWinner when the duel ended without a winner
+	StartTime       time.Time
+	DuelDuration    time.Duration
+	EventLog        []byte // models.EventLog encoded as JSON
+}
+
+// a deck built by a player, the cards are template IDs
+type DeckRecord struct {
+	ID       string
+	PlayerID string
+	Name     string
+	CardIDs  []int
+}
+
+// the cards owned by a player, copies by template ID
+type CardCollection struct {
+	PlayerID string
+	Cards    map[int]int
+}
+
+type PlayerRepository interface {
+	SavePlayer(ctx context.Context, player *models.Player) error
+	GetPlayer(ctx context.Context, playerID string) (*models.Player, error)
+}
+
+type DeckRepository interface {
+	SaveDeck(ctx context.Context, deck *DeckRecord) error
+	GetDeck(ctx context.Context, deckID string) (*DeckRecord, error)
+	ListDecks(ctx context.Context, playerID string) ([]*DeckRecord, error)
+	DeleteDeck(ctx context.Context, deckID string) error
+}
+
+type GameRepository interface {
+	SaveGame(ctx context.Context, game *GameRecord) error
+	GetGame(ctx context.Context, gameID string) (*GameRecord, error)
+	ListGames(ctx context.Context, playerID string) ([]*GameRecord, error)
+}
+
+type CardCollectionRepository interface {
+	GetCollection(ctx context.Context, playerID string) (*CardCollection, error)
+	AddCards(ctx context.Context, playerID string, templateIDs ...int) error
+}
+
+func NewGameRecord(game *models.Game) (*GameRecord, error) {
+	if state := game.GetState(); state != models.GameFinished {
+		return nil, fmt.Errorf("only games with State = %s can be recorded, got %s", models.GameFinished, state)
+	}
+	eventLog, err := json.Marshal(game.EventLog())
+	if err != nil {
+		return nil, err
+	}
+
+	record := &GameRecord{
+		ID:           game.ID,
+		WinnerIndex:  game.WinnerIndex,
+		StartTime:    game.StartTime,
+		DuelDuration: game.DuelDuration,
+		EventLog:     eventLog,
+	}
+	for playerIndex, deck := range game.Decks {
+		record.PlayerIDs[playerIndex] = deck.Player.ID
+		record.FinalLifePoints[playerIndex] = deck.Player.LifePoints
+	}
+	return record, nil
+}
+
+// decodes the event log so the game can be replayed with models.Replay
+func (r *GameRecord) Log() (*models.EventLog, error) {
+	log := &models.EventLog{}
+	if err := json.Unmarshal(r.EventLog, log); err != nil {
+		return nil, err
+	}
+	return log, nil
+}
+
+// archives the finished games of the engine along with the updated stats of their players
+type GameArchive struct {
+	Games   GameRepository
+	Players PlayerRepository
+}
+
+func (a *GameArchive) ArchiveGame(game *models.Game) error {
+	record, err := NewGameRecord(game)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	for _, deck := range game.Decks {
+		if err := a.Players.SavePlayer(ctx, deck.Player); err != nil {
+			return err
+		}
+	}
+	return a.Games.SaveGame(ctx, record)
+}
@@ -0,0 +1,81 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/marcodali/forbidden-memories-duel-online/internal/persistence/domain"
+	"github.com/marcodali/forbidden-memories-duel-online/internal/persistence/repository"
+	"github.com/marcodali/forbidden-memories-duel-online/pkg/models"
+	"github.com/marcodali/forbidden-memories-duel-online/pkg/utils"
+)
+
+const (
+	PLAYER_A = 0
+	PLAYER_B = 1
+)
+
+// player A starts with a monster in attack mode and player B with 100 life points left
+func newGameAboutToEnd(t *testing.T) *models.Game {
+	_, err := utils.LoadDefaultRegistry()
+	assert.NoError(t, err)
+
+	playerA, _ := models.NewPlayer("PlayerA")
+	playerB, _ := models.NewPlayer("PlayerB")
+	deckA, _ := models.NewDeck(playerA, [40]*models.CardInstance{})
+	deckB, _ := models.NewDeck(playerB, [40]*models.CardInstance{})
+	game, _ := models.NewGame([2]*models.Deck{deckA, deckB})
+
+	monster, _ := models.NewCardInstance(38)
+	monster.IsInAttackMode = true
+	game.Board.MonsterZones[PLAYER_A][0] = &models.CardState{Card: monster, FaceUp: true}
+	deckA.ActiveCardsOnBoard = append(deckA.ActiveCardsOnBoard, monster)
+	playerB.LifePoints = 100
+	assert.NoError(t, game.Start())
+	return game
+}
+
+func TestArchiveGame(t *testing.T) {
+	repo := repository.NewMemory()
+	engine := models.NewEngine()
+	engine.SetArchive(&domain.GameArchive{Games: repo, Players: repo})
+
+	game := newGameAboutToEnd(t)
+	assert.NoError(t, engine.AddGame(game))
+	_, err := domain.NewGameRecord(game)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "only games with State = FINISHED can be recorded")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+
+	assert.NoError(t, game.NextPhase())
+	assert.NoError(t, game.NextPhase())
+	battle, _ := models.NewEvent(&models.MonsterBattlePayload{PlayerIndex: PLAYER_A, AttackerPosition: 0, DefenderPosition: models.DirectAttack})
+	_, err = game.AddEventAndWait(context.Background(), battle)
+	assert.NoError(t, err)
+	assert.Equal(t, models.GameFinished, game.State)
+	assert.NoError(t, engine.RemoveGame(game.ID))
+
+	ctx := context.Background()
+	record, err := repo.GetGame(ctx, game.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, PLAYER_A, record.WinnerIndex)
+	assert.Equal(t, [2]int{8000, 0}, record.FinalLifePoints)
+	assert.Equal(t, game.DuelDuration, record.DuelDuration)
+	assert.Equal(t, game.Decks[PLAYER_B].Player.ID, record.PlayerIDs[PLAYER_B])
+
+	winner, err := repo.GetPlayer(ctx, record.PlayerIDs[PLAYER_A])
+	assert.NoError(t, err)
+	assert.Equal(t, 1, winner.WinCount)
+
+	// the archived log is enough to replay the whole duel
+	log, err := record.Log()
+	assert.NoError(t, err)
+	replayed, err := models.Replay(log)
+	assert.NoError(t, err)
+	assert.Equal(t, models.GameFinished, replayed.State)
+	assert.Equal(t, PLAYER_A, replayed.WinnerIndex)
+}
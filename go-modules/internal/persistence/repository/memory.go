@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/marcodali/forbidden-memories-duel-online/internal/persistence/domain"
+	"github.com/marcodali/forbidden-memories-duel-online/pkg/models"
+)
+
+// implements every repository with maps, meant for tests and local development
+type Memory struct {
+	players     map[string]models.Player
+	decks       map[string]domain.DeckRecord
+	games       map[string]domain.GameRecord
+	collections map[string]map[int]int
+	mutex       sync.RWMutex
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		players:     make(map[string]models.Player),
+		decks:       make(map[string]domain.DeckRecord),
+		games:       make(map[string]domain.GameRecord),
+		collections: make(map[string]map[int]int),
+	}
+}
+
+// records are copied in and out so callers cannot change what is stored
+func (m *Memory) SavePlayer(_ context.Context, player *models.Player) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.players[player.ID] = *player
+	return nil
+}
+
+func (m *Memory) GetPlayer(_ context.Context, playerID string) (*models.Player, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	player, exists := m.players[playerID]
+	if !exists {
+		return nil, domain.ErrNotFound
+	}
+	return &player, nil
+}
+
+func (m *Memory) SaveDeck(_ context.Context, deck *domain.DeckRecord) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	stored := *deck
+	stored.CardIDs = slices.Clone(deck.CardIDs)
+	m.decks[deck.ID] = stored
+	return nil
+}
+
+func (m *Memory) GetDeck(_ context.Context, deckID string) (*domain.DeckRecord, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	deck, exists := m.decks[deckID]
+	if !exists {
+		return nil, domain.ErrNotFound
+	}
+	deck.CardIDs = slices.Clone(deck.CardIDs)
+	return &deck, nil
+}
+
+func (m *Memory) ListDecks(_ context.Context, playerID string) ([]*domain.DeckRecord, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	decks := []*domain.DeckRecord{}
+	for _, deck := range m.decks {
+		if deck.PlayerID == playerID {
+			deck.CardIDs = slices.Clone(deck.CardIDs)
+			decks = append(decks, &deck)
+		}
+	}
+	sort.Slice(decks, func(i, j int) bool { return decks[i].Name < decks[j].Name })
+	return decks, nil
+}
+
+func (m *Memory) DeleteDeck(_ context.Context, deckID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if _, exists := m.decks[deckID]; !exists {
+		return domain.ErrNotFound
+	}
+	delete(m.decks, deckID)
+	return nil
+}
+
+// finished games never change, so saving one twice is an error
+func (m *Memory) SaveGame(_ context.Context, game *domain.GameRecord) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if _, exists := m.games[game.ID]; exists {
+		return fmt.Errorf("game %s is already saved", game.ID)
+	}
+	stored := *game
+	stored.EventLog = slices.Clone(game.EventLog)
+	m.games[game.ID] = stored
+	return nil
+}
+
+func (m *Memory) GetGame(_ context.Context, gameID string) (*domain.GameRecord, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	game, exists := m.games[gameID]
+	if !exists {
+		return nil, domain.ErrNotFound
+	}
+	game.EventLog = slices.Clone(game.EventLog)
+	return &game, nil
+}
+
+// the most recent games first
+func (m *Memory) ListGames(_ context.Context, playerID string) ([]*domain.GameRecord, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	games := []*domain.GameRecord{}
+	for _, game := range m.games {
+		if slices.Contains(game.PlayerIDs[:], playerID) {
+			game.EventLog = slices.Clone(game.EventLog)
+			games = append(games, &game)
+		}
+	}
+	sort.Slice(games, func(i, j int) bool { return games[i].StartTime.After(games[j].StartTime) })
+	return games, nil
+}
+
+func (m *Memory) GetCollection(_ context.Context, playerID string) (*domain.CardCollection, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	collection := &domain.CardCollection{PlayerID: playerID, Cards: map[int]int{}}
+	for templateID, copies := range m.collections[playerID] {
+		collection.Cards[templateID] = copies
+	}
+	return collection, nil
+}
+
+func (m *Memory) AddCards(_ context.Context, playerID string, templateIDs ...int) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.collections[playerID] == nil {
+		m.collections[playerID] = make(map[int]int)
+	}
+	for _, templateID := range templateIDs {
+		m.collections[playerID][templateID]++
+	}
+	return nil
+}
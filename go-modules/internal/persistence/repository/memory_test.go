@@ -0,0 +1,7 @@
+package repository
+
+import "testing"
+
+func TestMemory(t *testing.T) {
+	testRepository(t, NewMemory())
+}
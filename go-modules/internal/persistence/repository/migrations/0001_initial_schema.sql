@@ -0,0 +1,41 @@
+CREATE TABLE players (
+    id             TEXT PRIMARY KEY,
+    username       TEXT NOT NULL,
+    country        TEXT NOT NULL,
+    when_signed_up INTEGER NOT NULL,
+    last_login     INTEGER NOT NULL,
+    auth_provider  TEXT NOT NULL,
+    rating         INTEGER NOT NULL,
+    total_duels    INTEGER NOT NULL,
+    win_count      INTEGER NOT NULL,
+    loss_count     INTEGER NOT NULL
+);
+
+CREATE TABLE decks (
+    id        TEXT PRIMARY KEY,
+    player_id TEXT NOT NULL,
+    name      TEXT NOT NULL,
+    card_ids  TEXT NOT NULL
+);
+CREATE INDEX decks_player_id ON decks (player_id);
+
+CREATE TABLE games (
+    id              TEXT PRIMARY KEY,
+    player_a_id     TEXT NOT NULL,
+    player_b_id     TEXT NOT NULL,
+    life_points_a   INTEGER NOT NULL,
+    life_points_b   INTEGER NOT NULL,
+    winner_index    INTEGER NOT NULL,
+    start_time      INTEGER NOT NULL,
+    duel_duration   INTEGER NOT NULL,
+    event_log       BLOB NOT NULL
+);
+CREATE INDEX games_player_a_id ON games (player_a_id);
+CREATE INDEX games_player_b_id ON games (player_b_id);
+
+CREATE TABLE card_collections (
+    player_id   TEXT NOT NULL,
+    template_id INTEGER NOT NULL,
+    copies      INTEGER NOT NULL,
+    PRIMARY KEY (player_id, template_id)
+);
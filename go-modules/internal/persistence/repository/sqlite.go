@@ -0,0 +1,281 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite" // pure Go driver, no cgo needed
+
+	"github.com/marcodali/forbidden-memories-duel-online/internal/persistence/domain"
+	"github.com/marcodali/forbidden-memories-duel-online/pkg/models"
+)
+
+// applied in the order of their names, never edit a migration once released
+//
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// implements every repository on top of a SQLite database
+type SQLite struct {
+	db *sql.DB
+}
+
+// opens the database and applies the missing migrations, use ":memory:" for a throwaway database
+func OpenSQLite(dataSourceName string) (*SQLite, error) {
+	db, err := sql.Open("sqlite", dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+	// every connection to ":memory:" would get its own empty database
+	db.SetMaxOpenConns(1)
+
+	repository := &SQLite{db: db}
+	if err := repository.migrate(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return repository, nil
+}
+
+func (r *SQLite) Close() error {
+	return r.db.Close()
+}
+
+func (r *SQLite) migrate() error {
+	if _, err := r.db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (name TEXT PRIMARY KEY)`); err != nil {
+		return err
+	}
+	names, err := fs.Glob(migrations, "migrations/*.sql")
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		var applied int
+		if err := r.db.QueryRow(`SELECT COUNT(*) FROM schema_migrations WHERE name = ?`, name).Scan(&applied); err != nil {
+			return err
+		}
+		if applied > 0 {
+			continue
+		}
+		script, err := migrations.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		if err := r.inTransaction(func(tx *sql.Tx) error {
+			if _, err := tx.Exec(string(script)); err != nil {
+				return err
+			}
+			_, err := tx.Exec(`INSERT INTO schema_migrations (name) VALUES (?)`, name)
+			return err
+		}); err != nil {
+			return fmt.Errorf("cannot apply migration %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func (r *SQLite) inTransaction(run func(tx *sql.Tx) error) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := run(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// only the profile and stats are stored, the duel state of the player is not
+func (r *SQLite) SavePlayer(ctx context.Context, player *models.Player) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO players (id, username, country, when_signed_up, last_login, auth_provider, rating, total_duels, win_count, loss_count)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET
+			username = excluded.username, country = excluded.country, last_login = excluded.last_login,
+			auth_provider = excluded.auth_provider, rating = excluded.rating, total_duels = excluded.total_duels,
+			win_count = excluded.win_count, loss_count = excluded.loss_count`,
+		player.ID, player.Username, player.Country, player.WhenSignedUp.UnixNano(), player.LastLogin.UnixNano(),
+		player.AuthProvider, player.Rating, player.TotalDuels, player.WinCount, player.LossCount)
+	return err
+}
+
+func (r *SQLite) GetPlayer(ctx context.Context, playerID string) (*models.Player, error) {
+	player := &models.Player{}
+	var whenSignedUp, lastLogin int64
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, username, country, when_signed_up, last_login, auth_provider, rating, total_duels, win_count, loss_count
+		FROM players WHERE id = ?`, playerID).Scan(
+		&player.ID, &player.Username, &player.Country, &whenSignedUp, &lastLogin,
+		&player.AuthProvider, &player.Rating, &player.TotalDuels, &player.WinCount, &player.LossCount)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	player.WhenSignedUp = time.Unix(0, whenSignedUp)
+	player.LastLogin = time.Unix(0, lastLogin)
+	return player, nil
+}
+
+func (r *SQLite) SaveDeck(ctx context.Context, deck *domain.DeckRecord) error {
+	cardIDs, err := json.Marshal(deck.CardIDs)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO decks (id, player_id, name, card_ids) VALUES (?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET player_id = excluded.player_id, name = excluded.name, card_ids = excluded.card_ids`,
+		deck.ID, deck.PlayerID, deck.Name, string(cardIDs))
+	return err
+}
+
+func (r *SQLite) GetDeck(ctx context.Context, deckID string) (*domain.DeckRecord, error) {
+	decks, err := r.queryDecks(ctx, `SELECT id, player_id, name, card_ids FROM decks WHERE id = ?`, deckID)
+	if err != nil {
+		return nil, err
+	}
+	if len(decks) == 0 {
+		return nil, domain.ErrNotFound
+	}
+	return decks[0], nil
+}
+
+func (r *SQLite) ListDecks(ctx context.Context, playerID string) ([]*domain.DeckRecord, error) {
+	return r.queryDecks(ctx, `SELECT id, player_id, name, card_ids FROM decks WHERE player_id = ? ORDER BY name`, playerID)
+}
+
+func (r *SQLite) queryDecks(ctx context.Context, query string, args ...any) ([]*domain.DeckRecord, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	decks := []*domain.DeckRecord{}
+	for rows.Next() {
+		deck := &domain.DeckRecord{}
+		var cardIDs string
+		if err := rows.Scan(&deck.ID, &deck.PlayerID, &deck.Name, &cardIDs); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(cardIDs), &deck.CardIDs); err != nil {
+			return nil, err
+		}
+		decks = append(decks, deck)
+	}
+	return decks, rows.Err()
+}
+
+func (r *SQLite) DeleteDeck(ctx context.Context, deckID string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM decks WHERE id = ?`, deckID)
+	if err != nil {
+		return err
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *SQLite) SaveGame(ctx context.Context, game *domain.GameRecord) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO games (id, player_a_id, player_b_id, life_points_a, life_points_b, winner_index, start_time, duel_duration, event_log)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		game.ID, game.PlayerIDs[0], game.PlayerIDs[1], game.FinalLifePoints[0], game.FinalLifePoints[1],
+		game.WinnerIndex, game.StartTime.UnixNano(), int64(game.DuelDuration), game.EventLog)
+	return err
+}
+
+func (r *SQLite) GetGame(ctx context.Context, gameID string) (*domain.GameRecord, error) {
+	games, err := r.queryGames(ctx, `WHERE id = ?`, gameID)
+	if err != nil {
+		return nil, err
+	}
+	if len(games) == 0 {
+		return nil, domain.ErrNotFound
+	}
+	return games[0], nil
+}
+
+// the most recent games first
+func (r *SQLite) ListGames(ctx context.Context, playerID string) ([]*domain.GameRecord, error) {
+	return r.queryGames(ctx, `WHERE player_a_id = ? OR player_b_id = ? ORDER BY start_time DESC`, playerID, playerID)
+}
+
+func (r *SQLite) queryGames(ctx context.Context, where string, args ...any) ([]*domain.GameRecord, error) {
+	query := strings.Join([]string{
+		`SELECT id, player_a_id, player_b_id, life_points_a, life_points_b, winner_index, start_time, duel_duration, event_log FROM games`,
+		where,
+	}, " ")
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	games := []*domain.GameRecord{}
+	for rows.Next() {
+		game := &domain.GameRecord{}
+		var startTime, duelDuration int64
+		if err := rows.Scan(&game.ID, &game.PlayerIDs[0], &game.PlayerIDs[1], &game.FinalLifePoints[0], &game.FinalLifePoints[1],
+			&game.WinnerIndex, &startTime, &duelDuration, &game.EventLog); err != nil {
+			return nil, err
+		}
+		game.StartTime = time.Unix(0, startTime)
+		game.DuelDuration = time.Duration(duelDuration)
+		games = append(games, game)
+	}
+	return games, rows.Err()
+}
+
+func (r *SQLite) GetCollection(ctx context.Context, playerID string) (*domain.CardCollection, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT template_id, copies FROM card_collections WHERE player_id = ?`, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	collection := &domain.CardCollection{PlayerID: playerID, Cards: map[int]int{}}
+	for rows.Next() {
+		var templateID, copies int
+		if err := rows.Scan(&templateID, &copies); err != nil {
+			return nil, err
+		}
+		collection.Cards[templateID] = copies
+	}
+	return collection, rows.Err()
+}
+
+func (r *SQLite) AddCards(ctx context.Context, playerID string, templateIDs ...int) error {
+	return r.inTransaction(func(tx *sql.Tx) error {
+		for _, templateID := range templateIDs {
+			if _, err := tx.ExecContext(ctx, `
+				INSERT INTO card_collections (player_id, template_id, copies) VALUES (?, ?, 1)
+				ON CONFLICT (player_id, template_id) DO UPDATE SET copies = copies + 1`,
+				playerID, templateID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func notFound(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrNotFound
+	}
+	return err
+}
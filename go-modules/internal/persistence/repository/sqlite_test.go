@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/marcodali/forbidden-memories-duel-online/internal/persistence/domain"
+	"github.com/marcodali/forbidden-memories-duel-online/pkg/models"
+)
+
+// every repository implementation must pass these checks
+type repository interface {
+	domain.PlayerRepository
+	domain.DeckRepository
+	domain.GameRepository
+	domain.CardCollectionRepository
+}
+
+func testRepository(t *testing.T, repo repository) {
+	ctx := context.Background()
+
+	t.Run("players", func(t *testing.T) {
+		player, _ := models.NewPlayer("PlayerA")
+		assert.NoError(t, player.SetCountry(models.Mexico))
+		assert.NoError(t, repo.SavePlayer(ctx, player))
+
+		player.WinCount = 3
+		player.Rating = 1620
+		assert.NoError(t, repo.SavePlayer(ctx, player))
+
+		stored, err := repo.GetPlayer(ctx, player.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "PlayerA", stored.Username)
+		assert.Equal(t, models.Mexico, stored.Country)
+		assert.Equal(t, 3, stored.WinCount)
+		assert.Equal(t, 1620, stored.Rating)
+		assert.True(t, player.WhenSignedUp.Equal(stored.WhenSignedUp))
+
+		_, err = repo.GetPlayer(ctx, "unknown")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("decks", func(t *testing.T) {
+		dragons := &domain.DeckRecord{ID: "deck-1", PlayerID: "player-1", Name: "Dragons", CardIDs: []int{2, 3, 4}}
+		beasts := &domain.DeckRecord{ID: "deck-2", PlayerID: "player-1", Name: "Beasts", CardIDs: []int{5}}
+		assert.NoError(t, repo.SaveDeck(ctx, dragons))
+		assert.NoError(t, repo.SaveDeck(ctx, beasts))
+		assert.NoError(t, repo.SaveDeck(ctx, &domain.DeckRecord{ID: "deck-3", PlayerID: "player-2", Name: "Fairies"}))
+
+		dragons.CardIDs = append(dragons.CardIDs, 6)
+		assert.NoError(t, repo.SaveDeck(ctx, dragons))
+		stored, err := repo.GetDeck(ctx, "deck-1")
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 3, 4, 6}, stored.CardIDs)
+
+		decks, err := repo.ListDecks(ctx, "player-1")
+		assert.NoError(t, err)
+		assert.Len(t, decks, 2)
+		assert.Equal(t, "Beasts", decks[0].Name)
+
+		assert.NoError(t, repo.DeleteDeck(ctx, "deck-2"))
+		assert.ErrorIs(t, repo.DeleteDeck(ctx, "deck-2"), domain.ErrNotFound)
+		_, err = repo.GetDeck(ctx, "deck-2")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("games", func(t *testing.T) {
+		start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		older := &domain.GameRecord{
+			ID:              "game-1",
+			PlayerIDs:       [2]string{"player-1", "player-2"},
+			FinalLifePoints: [2]int{0, 4200},
+			WinnerIndex:     1,
+			StartTime:       start,
+			DuelDuration:    12 * time.Minute,
+			EventLog:        []byte(`{"Entries":[]}`),
+		}
+		newer := &domain.GameRecord{
+			ID:          "game-2",
+			PlayerIDs:   [2]string{"player-3", "player-1"},
+			WinnerIndex: models.NoWinner,
+			StartTime:   start.Add(time.Hour),
+			EventLog:    []byte(`{}`),
+		}
+		assert.NoError(t, repo.SaveGame(ctx, older))
+		assert.NoError(t, repo.SaveGame(ctx, newer))
+		assert.Error(t, repo.SaveGame(ctx, older), "finished games cannot be overwritten")
+
+		stored, err := repo.GetGame(ctx, "game-1")
+		assert.NoError(t, err)
+		assert.Equal(t, older.FinalLifePoints, stored.FinalLifePoints)
+		assert.Equal(t, 1, stored.WinnerIndex)
+		assert.Equal(t, 12*time.Minute, stored.DuelDuration)
+		assert.True(t, start.Equal(stored.StartTime))
+		assert.Equal(t, older.EventLog, stored.EventLog)
+
+		games, err := repo.ListGames(ctx, "player-1")
+		assert.NoError(t, err)
+		assert.Len(t, games, 2)
+		assert.Equal(t, "game-2", games[0].ID)
+
+		_, err = repo.GetGame(ctx, "unknown")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("collections", func(t *testing.T) {
+		assert.NoError(t, repo.AddCards(ctx, "player-1", 2, 3, 2))
+		assert.NoError(t, repo.AddCards(ctx, "player-1", 2))
+
+		collection, err := repo.GetCollection(ctx, "player-1")
+		assert.NoError(t, err)
+		assert.Equal(t, map[int]int{2: 3, 3: 1}, collection.Cards)
+
+		collection, err = repo.GetCollection(ctx, "player-2")
+		assert.NoError(t, err)
+		assert.Empty(t, collection.Cards)
+	})
+}
+
+func TestSQLite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "duels.db")
+	repo, err := OpenSQLite(path)
+	assert.NoError(t, err)
+	testRepository(t, repo)
+	assert.NoError(t, repo.Close())
+
+	// the migrations are applied once, the data survives reopening
+	repo, err = OpenSQLite(path)
+	assert.NoError(t, err)
+	defer repo.Close()
+	_, err = repo.GetGame(context.Background(), "game-1")
+	assert.NoError(t, err)
+}
+
+func TestSQLiteInMemory(t *testing.T) {
+	repo, err := OpenSQLite(":memory:")
+	assert.NoError(t, err)
+	defer repo.Close()
+	testRepository(t, repo)
+}
@@ -0,0 +1,183 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// a move of a player, only the actions of this file exist because the
+// methods of the interface are unexported, Game.Apply is the way to play them
+type Action interface {
+	actor() int
+	event(game *Game) (*Event, error)
+}
+
+type NextPhaseAction struct {
+	PlayerIndex int
+}
+
+type NextTurnAction struct {
+	PlayerIndex int
+}
+
+// places one card of the hand face-down, several hand indexes fuse the cards in that order first,
+// a monster placed on another monster of the player is fused with it, only one card is placed per turn
+type PlaceCardAction struct {
+	PlayerIndex    int
+	HandIndexes    []int
+	Position       int
+	IsInAttackMode bool
+	GuardianStar   GuardianStar
+}
+
+// switches a monster of the player between attack and defense mode
+type ChangePositionAction struct {
+	PlayerIndex int
+	Position    int
+}
+
+type AttackAction struct {
+	PlayerIndex      int
+	AttackerPosition int
+	DefenderPosition int // DirectAttack when the opponent has no monsters
+}
+
+// the card actions take the template ID of a card in the hand of the player
+type ActivateMagicCardAction struct {
+	PlayerIndex int
+	CardID      int
+}
+
+type ActivateFieldCardAction struct {
+	PlayerIndex int
+	CardID      int
+}
+
+type ActivateRitualAction struct {
+	PlayerIndex int
+	CardID      int
+	Position    int
+}
+
+type EquipCardAction struct {
+	PlayerIndex int
+	CardID      int
+	Position    int // monster of the player receiving the equip card
+}
+
+func (a *NextPhaseAction) actor() int         { return a.PlayerIndex }
+func (a *NextTurnAction) actor() int          { return a.PlayerIndex }
+func (a *PlaceCardAction) actor() int         { return a.PlayerIndex }
+func (a *ChangePositionAction) actor() int    { return a.PlayerIndex }
+func (a *AttackAction) actor() int            { return a.PlayerIndex }
+func (a *ActivateMagicCardAction) actor() int { return a.PlayerIndex }
+func (a *ActivateFieldCardAction) actor() int { return a.PlayerIndex }
+func (a *ActivateRitualAction) actor() int    { return a.PlayerIndex }
+func (a *EquipCardAction) actor() int         { return a.PlayerIndex }
+
+func (a *NextPhaseAction) event(game *Game) (*Event, error) {
+	return game.nextPhaseEvent()
+}
+
+func (a *NextTurnAction) event(game *Game) (*Event, error) {
+	return game.nextTurnEvent()
+}
+
+func (a *PlaceCardAction) event(game *Game) (*Event, error) {
+	if err := game.checkCardPlay(a.PlayerIndex, "place a card"); err != nil {
+		return nil, err
+	}
+	return NewEvent(&CardPlacedPayload{
+		PlayerIndex:    a.PlayerIndex,
+		HandIndexes:    a.HandIndexes,
+		Position:       a.Position,
+		IsInAttackMode: a.IsInAttackMode,
+		GuardianStar:   a.GuardianStar,
+	})
+}
+
+func (a *ChangePositionAction) event(game *Game) (*Event, error) {
+	return game.changePositionEvent(a.Position)
+}
+
+func (a *AttackAction) event(game *Game) (*Event, error) {
+	return game.attackEvent(a.AttackerPosition, a.DefenderPosition)
+}
+
+func (a *ActivateMagicCardAction) event(game *Game) (*Event, error) {
+	card, err := game.Decks[a.PlayerIndex].GetHandCard(a.CardID)
+	if err != nil {
+		return nil, err
+	}
+	return game.magicCardEvent(card)
+}
+
+func (a *ActivateFieldCardAction) event(game *Game) (*Event, error) {
+	card, err := game.Decks[a.PlayerIndex].GetHandCard(a.CardID)
+	if err != nil {
+		return nil, err
+	}
+	return game.fieldCardEvent(card)
+}
+
+func (a *ActivateRitualAction) event(game *Game) (*Event, error) {
+	card, err := game.Decks[a.PlayerIndex].GetHandCard(a.CardID)
+	if err != nil {
+		return nil, err
+	}
+	return game.ritualEvent(card, a.Position)
+}
+
+func (a *EquipCardAction) event(game *Game) (*Event, error) {
+	card, err := game.Decks[a.PlayerIndex].GetHandCard(a.CardID)
+	if err != nil {
+		return nil, err
+	}
+	return game.equipEvent(card, a.Position)
+}
+
+// plays the action of a player and waits for the events it leads to, so the error is either
+// the reason the action is not allowed right now or the reason its event was rejected
+func (g *Game) Apply(action Action) error {
+	return g.play(func() (*Event, error) {
+		if g.State != GameInProgress {
+			return nil, fmt.Errorf("cannot apply an action in the current game state, expected: %s, got: %s", GameInProgress, g.State)
+		}
+		if playerIndex := action.actor(); playerIndex != g.CurrentTurn.PlayerIndex {
+			return nil, fmt.Errorf("it is not the turn of player %d", playerIndex)
+		}
+		return action.event(g)
+	})
+}
+
+// checks that the player can make the move right now, the handlers of the moves check it
+// again because the turn may have passed, for instance by the clock, since the move was made
+func (g *Game) checkMove(playerIndex int, move string, phases ...TurnPhase) error {
+	if g.State != GameInProgress {
+		return fmt.Errorf("cannot %s in the current game state, expected: %s, got: %s", move, GameInProgress, g.State)
+	}
+	if playerIndex != g.CurrentTurn.PlayerIndex {
+		return fmt.Errorf("it is not the turn of player %d", playerIndex)
+	}
+	if !slices.Contains(phases, g.CurrentTurn.Phase) {
+		expected := make([]string, len(phases))
+		for index, phase := range phases {
+			expected[index] = string(phase)
+		}
+		return fmt.Errorf("cannot %s in the current turn phase, expected: %s, got: %s", move, strings.Join(expected, " or "), g.CurrentTurn.Phase)
+	}
+	return nil
+}
+
+// placing, activating, equipping and sacrificing for a ritual all play a card from the
+// hand, and only one card is played per turn
+func (g *Game) checkCardPlay(playerIndex int, move string) error {
+	if err := g.checkMove(playerIndex, move, PlaceCardsPhase); err != nil {
+		return err
+	}
+	if g.CurrentTurn.CardPlaced {
+		return errOneCardPerTurn
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package models
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// both decks have 40 cards to draw from and player A starts with Gaia the Fierce Knight,
+// Curse of Dragon and Baby Dragon in the hand plus the 2 cards drawn on start
+func newGameWithFusionInHand(t *testing.T) *Game {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	LoadRealFusionsFromYAML()
+
+	decks := [2]*Deck{newDeckOfRealCards("PlayerA"), newDeckOfRealCards("PlayerB")}
+	decks[PLAYER_A].HandCards = newHand(t, 38, 39, 4)
+	game, _ := NewGame(decks)
+	assert.NoError(t, game.Start())
+	return game
+}
+
+func TestApplyPlaysATurn(t *testing.T) {
+	game := newGameWithFusionInHand(t)
+	deckA := game.Decks[PLAYER_A]
+
+	assert.Len(t, deckA.HandCards, MaxHandSize)
+	assert.Len(t, deckA.RemainingCards, 38)
+	assert.NoError(t, game.Apply(&NextPhaseAction{PlayerIndex: PLAYER_A}))
+
+	// Gaia the Fierce Knight and Curse of Dragon become Gaia the Dragon Champion
+	assert.NoError(t, game.Apply(&PlaceCardAction{PlayerIndex: PLAYER_A, HandIndexes: []int{0, 1}, Position: 2, IsInAttackMode: true}))
+	champion := game.Board.MonsterZones[PLAYER_A][2]
+	assert.Equal(t, 37, champion.Card.Template.ID)
+	assert.Equal(t, []*CardInstance{champion.Card}, deckA.ActiveCardsOnBoard)
+	assert.Equal(t, []int{38, 39}, []int{deckA.DestroyedCards[0].Template.ID, deckA.DestroyedCards[1].Template.ID})
+	assert.Len(t, deckA.HandCards, 3)
+	assert.Equal(t, 4, deckA.HandCards[0].Template.ID)
+
+	assert.NoError(t, game.Apply(&NextPhaseAction{PlayerIndex: PLAYER_A}))
+	assert.False(t, champion.FaceUp)
+	assert.NoError(t, game.Apply(&AttackAction{PlayerIndex: PLAYER_A, AttackerPosition: 2, DefenderPosition: DirectAttack}))
+	assert.Equal(t, 8000-2600, game.Decks[PLAYER_B].Player.LifePoints)
+	assert.True(t, champion.FaceUp, "attacking reveals the attacker")
+	assert.NoError(t, game.Apply(&NextPhaseAction{PlayerIndex: PLAYER_A}))
+	assert.NoError(t, game.Apply(&NextTurnAction{PlayerIndex: PLAYER_A}))
+	assert.Len(t, game.Decks[PLAYER_B].HandCards, MaxHandSize)
+	passTurn(t, game, PLAYER_B)
+
+	// Baby Dragon goes face down in defense mode on the next turn
+	assert.NoError(t, game.Apply(&NextPhaseAction{PlayerIndex: PLAYER_A}))
+	assert.NoError(t, game.Apply(&PlaceCardAction{PlayerIndex: PLAYER_A, HandIndexes: []int{0}, Position: 0, GuardianStar: GuardianStarSun}))
+	babyDragon := game.Board.MonsterZones[PLAYER_A][0]
+	assert.Equal(t, 4, babyDragon.Card.Template.ID)
+	assert.False(t, babyDragon.FaceUp)
+	assert.False(t, babyDragon.Card.IsInAttackMode)
+	assert.Equal(t, GuardianStarSun, babyDragon.GuardianStar)
+
+	// then it goes to attack mode, which reveals it
+	assert.NoError(t, game.Apply(&ChangePositionAction{PlayerIndex: PLAYER_A, Position: 0}))
+	assert.True(t, babyDragon.Card.IsInAttackMode)
+	assert.True(t, babyDragon.FaceUp)
+
+	// the actions are recorded as events, so the game can be replayed
+	replayed, err := Replay(game.EventLog())
+	assert.NoError(t, err)
+	assert.Equal(t, 37, replayed.Board.MonsterZones[PLAYER_A][2].Card.Template.ID)
+	assert.Equal(t, 4, replayed.Board.MonsterZones[PLAYER_A][0].Card.Template.ID)
+	assert.True(t, replayed.Board.MonsterZones[PLAYER_A][0].Card.IsInAttackMode)
+	assert.Equal(t, 8000-2600, replayed.Decks[PLAYER_B].Player.LifePoints)
+	assert.Equal(t, templateIDs(game.Decks[PLAYER_B].HandCards), templateIDs(replayed.Decks[PLAYER_B].HandCards))
+}
+
+func TestApplyRejectsIllegalActions(t *testing.T) {
+	game := newGameWithFusionInHand(t)
+	tests := []struct {
+		name     string
+		action   Action
+		expected string
+	}{
+		{"out of turn", &NextPhaseAction{PlayerIndex: PLAYER_B}, "it is not the turn of player 1"},
+		{"unknown player", &NextPhaseAction{PlayerIndex: 7}, "it is not the turn of player 7"},
+		{"wrong phase", &PlaceCardAction{PlayerIndex: PLAYER_A, HandIndexes: []int{0}}, "cannot place a card in the current turn phase"},
+		{"card not owned", &ActivateMagicCardAction{PlayerIndex: PLAYER_A, CardID: 342}, "card 342 is not in the hand"},
+		{"card not owned to equip", &EquipCardAction{PlayerIndex: PLAYER_A, CardID: 301, Position: 0}, "card 301 is not in the hand"},
+		{"turn not over", &NextTurnAction{PlayerIndex: PLAYER_A}, "cannot advance turn in the current turn phase"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := game.Apply(test.action)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.expected)
+		})
+	}
+
+	assert.NoError(t, game.Apply(&NextPhaseAction{PlayerIndex: PLAYER_A}))
+	assert.NoError(t, game.Apply(&PlaceCardAction{PlayerIndex: PLAYER_A, HandIndexes: []int{0, 1}, Position: 2}))
+	err := game.Apply(&PlaceCardAction{PlayerIndex: PLAYER_A, HandIndexes: []int{0}, Position: 0})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "only one card can be placed per turn")
+	assert.Len(t, game.Decks[PLAYER_A].HandCards, 3, "rejected placements keep the hand")
+
+	// activating, equipping and sacrificing for a ritual also play the card of the turn
+	game.Decks[PLAYER_A].HandCards = append(game.Decks[PLAYER_A].HandCards, newHand(t, 342, 332, 667, 301)...)
+	for _, action := range []Action{
+		&ActivateMagicCardAction{PlayerIndex: PLAYER_A, CardID: 342},
+		&ActivateFieldCardAction{PlayerIndex: PLAYER_A, CardID: 332},
+		&ActivateRitualAction{PlayerIndex: PLAYER_A, CardID: 667, Position: 0},
+		&EquipCardAction{PlayerIndex: PLAYER_A, CardID: 301, Position: 2},
+	} {
+		err := game.Apply(action)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "only one card can be placed per turn")
+	}
+	assert.Len(t, game.Decks[PLAYER_A].HandCards, 7)
+
+	game.Finish()
+	err = game.Apply(&NextPhaseAction{PlayerIndex: PLAYER_A})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot apply an action in the current game state")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+func TestActionsCheckedAgainWhenTheirEventIsProcessed(t *testing.T) {
+	game := newGameWithFusionInHand(t)
+	assert.NoError(t, game.Apply(&NextPhaseAction{PlayerIndex: PLAYER_A}))
+	place, err := (&PlaceCardAction{PlayerIndex: PLAYER_A, HandIndexes: []int{2}, Position: 0}).event(game)
+	assert.NoError(t, err)
+
+	// the clock passes the turn before the placement is processed
+	timedOut, _ := NewEvent(&TurnTimedOutPayload{PlayerIndex: PLAYER_A})
+	_, err = game.AddEventAndWait(context.Background(), timedOut)
+	assert.NoError(t, err)
+
+	_, err = game.AddEventAndWait(context.Background(), place)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "it is not the turn of player 0")
+	assert.Nil(t, game.Board.MonsterZones[PLAYER_A][0])
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
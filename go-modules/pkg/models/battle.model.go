@@ -0,0 +1,129 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+// the defender position used to attack the opponent's life points directly
+const DirectAttack = -1
+
+// outcome of a battle between two monsters
+type BattleResult struct {
+	AttackerDestroyed bool
+	DefenderDestroyed bool
+	AttackerDamage    int // life points lost by the owner of the attacker
+	DefenderDamage    int // life points lost by the owner of the defender
+}
+
+// applies the Forbidden Memories battle rules, the defender can be in attack or defense mode
+func ResolveBattle(attacker, defender *CardState) BattleResult {
+	attack := attacker.Card.CurrentAttack
+	defenderAttack := defender.Card.CurrentAttack
+	defense := defender.Card.CurrentDefense
+
+	// the guardian star advantage only lasts for this battle
+	if attacker.GuardianStar.Beats(defender.GuardianStar) {
+		attack += GuardianStarBonus
+	}
+	if defender.GuardianStar.Beats(attacker.GuardianStar) {
+		defenderAttack += GuardianStarBonus
+		defense += GuardianStarBonus
+	}
+
+	if !defender.Card.IsInAttackMode {
+		switch {
+		case attack > defense:
+			return BattleResult{DefenderDestroyed: true}
+		case attack < defense:
+			return BattleResult{AttackerDamage: defense - attack}
+		}
+		return BattleResult{}
+	}
+
+	switch {
+	case attack > defenderAttack:
+		return BattleResult{DefenderDestroyed: true, DefenderDamage: attack - defenderAttack}
+	case attack < defenderAttack:
+		return BattleResult{AttackerDestroyed: true, AttackerDamage: defenderAttack - attack}
+	}
+	return BattleResult{AttackerDestroyed: true, DefenderDestroyed: true}
+}
+
+// declares an attack of the current player against an opponent monster,
+// use DirectAttack as defenderPosition when the opponent has no monsters
+func (g *Game) Attack(attackerPosition, defenderPosition int) error {
+	return g.play(func() (*Event, error) {
+		return g.attackEvent(attackerPosition, defenderPosition)
+	})
+}
+
+func (g *Game) attackEvent(attackerPosition, defenderPosition int) (*Event, error) {
+	if err := g.checkMove(g.CurrentTurn.PlayerIndex, "attack", ActionPhase); err != nil {
+		return nil, err
+	}
+	if g.CurrentTurn.CurrentPlayer.RemainingTurnsToAtack > 0 {
+		return nil, fmt.Errorf("player cannot attack for %d more turns", g.CurrentTurn.CurrentPlayer.RemainingTurnsToAtack)
+	}
+
+	attackerIndex := g.CurrentTurn.PlayerIndex
+	attacker, err := g.Board.GetMonsterAtIndexPosition(attackerIndex, attackerPosition)
+	if err != nil {
+		return nil, err
+	}
+	if attacker == nil {
+		return nil, fmt.Errorf("there is no monster to attack with at position %d", attackerPosition)
+	}
+	if !attacker.Card.IsInAttackMode {
+		return nil, errors.New("monsters in defense mode cannot attack")
+	}
+	if g.CurrentTurn.Attacked[attackerPosition] {
+		return nil, errAttackedThisTurn(attackerPosition)
+	}
+
+	defenderIndex := (attackerIndex + 1) % 2
+	if defenderPosition == DirectAttack {
+		if g.Board.CountMonsters(defenderIndex) > 0 {
+			return nil, errors.New("cannot attack directly while the opponent has monsters")
+		}
+	} else {
+		defender, err := g.Board.GetMonsterAtIndexPosition(defenderIndex, defenderPosition)
+		if err != nil {
+			return nil, err
+		}
+		if defender == nil {
+			return nil, fmt.Errorf("there is no monster to attack at position %d", defenderPosition)
+		}
+	}
+
+	return NewEvent(&MonsterBattlePayload{
+		PlayerIndex:      attackerIndex,
+		AttackerPosition: attackerPosition,
+		DefenderPosition: defenderPosition,
+	})
+}
+
+func errAttackedThisTurn(position int) error {
+	return fmt.Errorf("the monster at position %d already attacked this turn", position)
+}
+
+// removes the monster from the board and sends it to its owner's graveyard
+func (g *Game) destroyMonster(playerIndex, position int) {
+	state, _ := g.Board.RemoveMonsterAtIndexPosition(playerIndex, position)
+	if state == nil {
+		return
+	}
+	g.Decks[playerIndex].DestroyCard(state.Card)
+}
+
+// makes the player lose life points through its own event
+func (g *Game) damagePlayer(playerIndex, damage int) error {
+	if damage <= 0 {
+		return nil
+	}
+	event, err := NewEvent(&DirectDamageToLifePointsPayload{PlayerIndex: playerIndex, Damage: damage})
+	if err != nil {
+		return err
+	}
+	return g.dispatch(event)
+}
@@ -0,0 +1,178 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newMonsterState(attack, defense int, isInAttackMode bool) *CardState {
+	return &CardState{
+		Card: &CardInstance{
+			Template:       &CardTemplate{Name: "Test Monster", BaseAttack: attack, BaseDefense: defense},
+			IsInAttackMode: isInAttackMode,
+			CurrentAttack:  attack,
+			CurrentDefense: defense,
+		},
+		FaceUp: true,
+	}
+}
+
+// returns a started game where playerA is in the action phase of the first turn
+func newGameInActionPhase() *Game {
+	playerA, _ := NewPlayer("PlayerA")
+	playerB, _ := NewPlayer("PlayerB")
+	deckA, _ := NewDeck(playerA, [40]*CardInstance{})
+	deckB, _ := NewDeck(playerB, [40]*CardInstance{})
+	game, _ := NewGame([2]*Deck{deckA, deckB})
+	game.Start()
+	// the decks are empty so the cards drawn on start are dropped
+	game.Decks[PLAYER_A].HandCards = []*CardInstance{}
+	game.CurrentTurn.Phase = ActionPhase
+	return game
+}
+
+func placeMonster(game *Game, playerIndex, position int, state *CardState) {
+	state.IndexPosition = position
+	game.Board.MonsterZones[playerIndex][position] = state
+	game.Decks[playerIndex].ActiveCardsOnBoard = append(game.Decks[playerIndex].ActiveCardsOnBoard, state.Card)
+}
+
+func TestResolveBattle(t *testing.T) {
+	tests := []struct {
+		name     string
+		attacker *CardState
+		defender *CardState
+		expected BattleResult
+	}{
+		{
+			name:     "Stronger attacker destroys the attacking defender",
+			attacker: newMonsterState(2000, 0, true),
+			defender: newMonsterState(1500, 0, true),
+			expected: BattleResult{DefenderDestroyed: true, DefenderDamage: 500},
+		},
+		{
+			name:     "Weaker attacker is destroyed by the attacking defender",
+			attacker: newMonsterState(1000, 0, true),
+			defender: newMonsterState(1800, 0, true),
+			expected: BattleResult{AttackerDestroyed: true, AttackerDamage: 800},
+		},
+		{
+			name:     "Equal attack destroys both monsters",
+			attacker: newMonsterState(1200, 0, true),
+			defender: newMonsterState(1200, 0, true),
+			expected: BattleResult{AttackerDestroyed: true, DefenderDestroyed: true},
+		},
+		{
+			name:     "Attack above defense destroys the defender without damage",
+			attacker: newMonsterState(1200, 0, true),
+			defender: newMonsterState(3000, 1000, false),
+			expected: BattleResult{DefenderDestroyed: true},
+		},
+		{
+			name:     "Attack below defense hurts the attacker owner",
+			attacker: newMonsterState(1200, 0, true),
+			defender: newMonsterState(0, 2000, false),
+			expected: BattleResult{AttackerDamage: 800},
+		},
+		{
+			name:     "Attack equal to defense does nothing",
+			attacker: newMonsterState(1200, 0, true),
+			defender: newMonsterState(0, 1200, false),
+			expected: BattleResult{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ResolveBattle(tt.attacker, tt.defender))
+		})
+	}
+}
+
+func TestAttackDestroysMonstersAndDamagesPlayers(t *testing.T) {
+	game := newGameInActionPhase()
+	attacker := newMonsterState(2000, 0, true)
+	defender := newMonsterState(1500, 1000, true)
+	defender.FaceUp = false
+	placeMonster(game, PLAYER_A, 0, attacker)
+	placeMonster(game, PLAYER_B, 2, defender)
+
+	err := game.Attack(0, 2)
+	assert.NoError(t, err)
+
+	assert.True(t, defender.FaceUp, "face-down defenders are flipped when attacked")
+	assert.Nil(t, game.Board.MonsterZones[PLAYER_B][2])
+	assert.Empty(t, game.Decks[PLAYER_B].ActiveCardsOnBoard)
+	assert.Equal(t, []*CardInstance{defender.Card}, game.Decks[PLAYER_B].DestroyedCards)
+	assert.Equal(t, attacker, game.Board.MonsterZones[PLAYER_A][0])
+	assert.Equal(t, 8000, game.Decks[PLAYER_A].Player.LifePoints)
+	assert.Equal(t, 7500, game.Decks[PLAYER_B].Player.LifePoints)
+
+	// the opponent has no monsters left but the attacker already attacked this turn
+	err = game.Attack(0, DirectAttack)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the monster at position 0 already attacked this turn")
+	assert.Equal(t, 7500, game.Decks[PLAYER_B].Player.LifePoints)
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+func TestAttackWithInvalidState(t *testing.T) {
+	game := newGameInActionPhase()
+	placeMonster(game, PLAYER_A, 0, newMonsterState(2000, 0, true))
+	placeMonster(game, PLAYER_A, 1, newMonsterState(2000, 0, false))
+	placeMonster(game, PLAYER_B, 0, newMonsterState(1000, 0, true))
+
+	err := game.Attack(3, 0)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "there is no monster to attack with")
+
+	err = game.Attack(1, 0)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "monsters in defense mode cannot attack")
+
+	err = game.Attack(0, 4)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "there is no monster to attack at position")
+
+	err = game.Attack(0, 5)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid card index position")
+
+	err = game.Attack(0, DirectAttack)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot attack directly while the opponent has monsters")
+
+	game.CurrentTurn.CurrentPlayer.RemainingTurnsToAtack = 2
+	err = game.Attack(0, 0)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "player cannot attack for 2 more turns")
+
+	game.CurrentTurn.Phase = PlaceCardsPhase
+	err = game.Attack(0, 0)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot attack in the current turn phase")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+func TestDirectAttackFinishesTheGame(t *testing.T) {
+	game := newGameInActionPhase()
+	placeMonster(game, PLAYER_A, 0, newMonsterState(2000, 0, true))
+	playerA := game.Decks[PLAYER_A].Player
+	playerB := game.Decks[PLAYER_B].Player
+	playerB.LifePoints = 1500
+
+	err := game.Attack(0, DirectAttack)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 0, playerB.LifePoints)
+	assert.Equal(t, GameFinished, game.State)
+	assert.Equal(t, 1, playerA.WinCount)
+	assert.Equal(t, 1, playerA.TotalDuels)
+	assert.Equal(t, 1, playerB.LossCount)
+	assert.Equal(t, 1, playerB.TotalDuels)
+}
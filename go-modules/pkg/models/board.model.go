@@ -14,20 +14,24 @@ const (
 )
 
 type CardState struct {
-	Card          *CardInstance
-	FaceUp        bool
-	IndexPosition int
+	Card           *CardInstance
+	FaceUp         bool
+	IndexPosition  int
+	GuardianStar   GuardianStar // chosen by the player when a monster is placed
+	TerrainAttack  int          // points the terrain actually added to the monster, taken away when it leaves
+	TerrainDefense int
 }
 
 // represents the complete playing field for both players
 type Board struct {
 	MonsterZones   [2][5]*CardState // [playerTurn][position]
 	MagicTrapZones [2][5]*CardState // [playerTurn][position]
-	FieldZone      [2]*CardState    // current battle field monsters for both players
+	FieldZone      [2]*CardState    // field magic card activated by each player
+	Terrain        Terrain          // land shared by both players, changed by field cards
 }
 
 func NewBoard() *Board {
-	return &Board{}
+	return &Board{Terrain: TerrainNormal}
 }
 
 // places a card in its corresponding zone based on its type
@@ -38,14 +42,98 @@ func (b *Board) SetCardAtIndexPosition(state *CardState, currentTurn int) error
 
 	switch state.Card.Template.Type {
 	case TypeMagic, TypeTrap, TypeEquip, TypeRitual:
+		if b.MagicTrapZones[currentTurn][state.IndexPosition] != nil {
+			return fmt.Errorf("position %d is already taken", state.IndexPosition)
+		}
 		b.MagicTrapZones[currentTurn][state.IndexPosition] = state
 		return nil
 	}
 
 	if validMonsterTypes[state.Card.Template.Type] {
+		if b.MonsterZones[currentTurn][state.IndexPosition] != nil {
+			return fmt.Errorf("position %d is already taken", state.IndexPosition)
+		}
+		if err := chooseGuardianStar(state); err != nil {
+			return err
+		}
 		b.MonsterZones[currentTurn][state.IndexPosition] = state
+		addTerrainBonus(state, b.Terrain)
 		return nil
 	}
 
 	return fmt.Errorf("invalid card type %q", state.Card.Template.Type)
 }
+
+// returns the monster of the player at the given position, nil when the slot is empty
+func (b *Board) GetMonsterAtIndexPosition(playerIndex, position int) (*CardState, error) {
+	if err := checkBoardPosition(playerIndex, position); err != nil {
+		return nil, err
+	}
+	return b.MonsterZones[playerIndex][position], nil
+}
+
+// takes the monster out of the board removing its terrain bonus, nil when the slot is empty
+func (b *Board) RemoveMonsterAtIndexPosition(playerIndex, position int) (*CardState, error) {
+	state, err := b.GetMonsterAtIndexPosition(playerIndex, position)
+	if err != nil || state == nil {
+		return nil, err
+	}
+	removeTerrainBonus(state)
+	b.MonsterZones[playerIndex][position] = nil
+	return state, nil
+}
+
+// checks that the player exists so a bad payload cannot index the decks out of range
+func checkPlayerIndex(playerIndex int) error {
+	if playerIndex < 0 || playerIndex >= 2 {
+		return fmt.Errorf("invalid player index: %d", playerIndex)
+	}
+	return nil
+}
+
+// checks that the slot exists on the board so a bad payload cannot index out of range
+func checkBoardPosition(playerIndex, position int) error {
+	if err := checkPlayerIndex(playerIndex); err != nil {
+		return err
+	}
+	if position < 0 || position >= 5 {
+		return fmt.Errorf("invalid card index position: %d", position)
+	}
+	return nil
+}
+
+// moves the duel to a new terrain recomputing the points of every monster on both sides
+func (b *Board) ChangeTerrain(terrain Terrain) {
+	for playerIndex := range b.MonsterZones {
+		for _, state := range b.MonsterZones[playerIndex] {
+			if state == nil {
+				continue
+			}
+			removeTerrainBonus(state)
+			addTerrainBonus(state, terrain)
+		}
+	}
+	b.Terrain = terrain
+}
+
+// counts the monsters the player has on the board
+func (b *Board) CountMonsters(playerIndex int) int {
+	count := 0
+	for _, state := range b.MonsterZones[playerIndex] {
+		if state != nil {
+			count++
+		}
+	}
+	return count
+}
+
+// monsters placed without a chosen guardian star take the first one of their template
+func chooseGuardianStar(state *CardState) error {
+	if state.GuardianStar != "" {
+		return state.SetGuardianStar(state.GuardianStar)
+	}
+	if len(state.Card.Template.GuardianStars) > 0 {
+		state.GuardianStar = state.Card.Template.GuardianStars[0]
+	}
+	return nil
+}
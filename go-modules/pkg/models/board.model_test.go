@@ -125,6 +125,24 @@ func TestSetCardAtIndexPositionOnlyMagicTypes(t *testing.T) {
 		assert.Equal(t, cardStatePlayerB, board.MagicTrapZones[turnOfPlayerB][indexPosition])
 	})
 
+	t.Run("should fail at placing the card on a taken position", func(t *testing.T) {
+		taken := board.MagicTrapZones[turnOfPlayerB][4]
+		cardStatePlayerB := &CardState{Card: shadowSpell, FaceUp: false, IndexPosition: 4}
+		err := board.SetCardAtIndexPosition(cardStatePlayerB, turnOfPlayerB)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "position 4 is already taken")
+		assert.Same(t, taken, board.MagicTrapZones[turnOfPlayerB][4])
+
+		babyDragon, _ := NewCardInstance(4)
+		assert.NoError(t, board.SetCardAtIndexPosition(&CardState{Card: babyDragon, IndexPosition: 2}, turnOfPlayerA))
+		err = board.SetCardAtIndexPosition(&CardState{Card: babyDragon, IndexPosition: 2}, turnOfPlayerA)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "position 2 is already taken")
+
+		// to see this error message, run the test with -v flag
+		t.Logf("Error: %v", err)
+	})
+
 	t.Run("should fail at placing the card because invalid type", func(t *testing.T) {
 		invalidCard, _ := NewCardInstance(1)
 		invalidCard.Template.Type = "Not a valid type card"
@@ -0,0 +1,33 @@
+package models
+
+import "fmt"
+
+type BulkCardDestructionPayload struct {
+	Zone      Zone
+	Positions []BoardPosition
+}
+
+func (*BulkCardDestructionPayload) EventType() EventType { return EventBulkCardDestruction }
+
+func EventBulkCardDestructionFn(game *Game, payload *BulkCardDestructionPayload) error {
+	if err := checkBoardPositions(payload.Positions); err != nil {
+		return err
+	}
+	fmt.Printf("Destroying %d cards of the %s zone...\n", len(payload.Positions), payload.Zone)
+	for _, slot := range payload.Positions {
+		switch payload.Zone {
+		case ZoneMonster:
+			game.destroyMonster(slot.PlayerIndex, slot.Position)
+		case ZoneMagicTrap:
+			state := game.Board.MagicTrapZones[slot.PlayerIndex][slot.Position]
+			if state == nil {
+				continue
+			}
+			game.Board.MagicTrapZones[slot.PlayerIndex][slot.Position] = nil
+			game.Decks[slot.PlayerIndex].DestroyCard(state.Card)
+		default:
+			return fmt.Errorf("invalid zone %q", payload.Zone)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvalidEventBulkCardDestructionFn(t *testing.T) {
+	game := newGameInActionPhase()
+	err := EventBulkCardDestructionFn(game, &BulkCardDestructionPayload{
+		Zone:      ZoneField,
+		Positions: []BoardPosition{{PlayerIndex: PLAYER_A, Position: 0}},
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid zone")
+
+	monster := newMonsterState(1000, 1000, true)
+	placeMonster(game, PLAYER_B, 0, monster)
+	err = EventBulkCardDestructionFn(game, &BulkCardDestructionPayload{
+		Zone:      ZoneMonster,
+		Positions: []BoardPosition{{PlayerIndex: PLAYER_B, Position: 0}, {PlayerIndex: PLAYER_B, Position: -1}},
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid card index position: -1")
+	assert.Same(t, monster, game.Board.MonsterZones[PLAYER_B][0])
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
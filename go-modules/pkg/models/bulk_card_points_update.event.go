@@ -0,0 +1,26 @@
+package models
+
+import "fmt"
+
+type BulkCardPointsUpdatePayload struct {
+	Positions []BoardPosition
+	Points    int // negative values weaken the monsters
+}
+
+func (*BulkCardPointsUpdatePayload) EventType() EventType { return EventBulkCardPointsUpdate }
+
+func EventBulkCardPointsUpdateFn(game *Game, payload *BulkCardPointsUpdatePayload) error {
+	if err := checkBoardPositions(payload.Positions); err != nil {
+		return err
+	}
+	fmt.Printf("Updating the points of %d monsters by %d...\n", len(payload.Positions), payload.Points)
+	for _, slot := range payload.Positions {
+		state := game.Board.MonsterZones[slot.PlayerIndex][slot.Position]
+		if state == nil {
+			continue
+		}
+		state.Card.CurrentAttack = max(state.Card.CurrentAttack+payload.Points, 0)
+		state.Card.CurrentDefense = max(state.Card.CurrentDefense+payload.Points, 0)
+	}
+	return nil
+}
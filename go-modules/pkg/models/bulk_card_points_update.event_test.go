@@ -0,0 +1,34 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventBulkCardPointsUpdateFnWithSuccess(t *testing.T) {
+	game := newGameInActionPhase()
+	monster := newMonsterState(1000, 300, true)
+	placeMonster(game, PLAYER_B, 1, monster)
+
+	err := EventBulkCardPointsUpdateFn(game, &BulkCardPointsUpdatePayload{
+		Positions: []BoardPosition{{PlayerIndex: PLAYER_B, Position: 1}, {PlayerIndex: PLAYER_B, Position: 2}},
+		Points:    -500,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 500, monster.Card.CurrentAttack)
+	assert.Equal(t, 0, monster.Card.CurrentDefense, "points cannot go below zero")
+}
+
+func TestInvalidEventBulkCardPointsUpdateFn(t *testing.T) {
+	game := newGameInActionPhase()
+	err := EventBulkCardPointsUpdateFn(game, &BulkCardPointsUpdatePayload{
+		Positions: []BoardPosition{{PlayerIndex: 3, Position: 1}},
+		Points:    -500,
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid player index: 3")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
@@ -0,0 +1,35 @@
+package models
+
+import "fmt"
+
+// changes many monsters at once as magic effects do, these changes do not count against
+// the one position change per monster and turn
+type BulkCardStateAndPositionChangedPayload struct {
+	Positions      []BoardPosition
+	IsInAttackMode *bool // nil keeps the battle position of the monsters
+	FaceUp         *bool // nil keeps the monsters face-up or face-down
+}
+
+func (*BulkCardStateAndPositionChangedPayload) EventType() EventType {
+	return EventBulkCardStateAndPositionChanged
+}
+
+func EventBulkCardStateAndPositionChangedFn(game *Game, payload *BulkCardStateAndPositionChangedPayload) error {
+	if err := checkBoardPositions(payload.Positions); err != nil {
+		return err
+	}
+	fmt.Printf("Changing the state of %d monsters...\n", len(payload.Positions))
+	for _, slot := range payload.Positions {
+		state := game.Board.MonsterZones[slot.PlayerIndex][slot.Position]
+		if state == nil {
+			continue
+		}
+		if payload.IsInAttackMode != nil {
+			state.Card.IsInAttackMode = *payload.IsInAttackMode
+		}
+		if payload.FaceUp != nil {
+			state.FaceUp = *payload.FaceUp
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventBulkCardStateAndPositionChangedFn(t *testing.T) {
+	game := newGameInActionPhase()
+	first := newMonsterState(1000, 1000, true)
+	second := newMonsterState(1000, 1000, false)
+	first.FaceUp, second.FaceUp = false, false
+	placeMonster(game, PLAYER_B, 0, first)
+	placeMonster(game, PLAYER_B, 1, second)
+	positions := []BoardPosition{{PlayerIndex: PLAYER_B, Position: 0}, {PlayerIndex: PLAYER_B, Position: 1}, {PlayerIndex: PLAYER_B, Position: 4}}
+
+	toDefense := false
+	err := EventBulkCardStateAndPositionChangedFn(game, &BulkCardStateAndPositionChangedPayload{Positions: positions, IsInAttackMode: &toDefense})
+	assert.NoError(t, err)
+	assert.False(t, first.Card.IsInAttackMode)
+	assert.False(t, second.Card.IsInAttackMode)
+	assert.False(t, first.FaceUp, "the monsters stay face-down")
+
+	faceUp := true
+	err = EventBulkCardStateAndPositionChangedFn(game, &BulkCardStateAndPositionChangedPayload{Positions: positions, FaceUp: &faceUp})
+	assert.NoError(t, err)
+	assert.True(t, first.FaceUp)
+	assert.True(t, second.FaceUp)
+	assert.False(t, first.Card.IsInAttackMode, "the monsters keep their position")
+
+	// these changes do not use the change of the turn
+	assert.Equal(t, [5]bool{}, game.CurrentTurn.PositionChanged)
+}
+
+func TestInvalidEventBulkCardStateAndPositionChangedFn(t *testing.T) {
+	game := newGameInActionPhase()
+	monster := newMonsterState(1000, 1000, true)
+	placeMonster(game, PLAYER_B, 0, monster)
+	toDefense := false
+
+	tests := []struct {
+		name     string
+		slot     BoardPosition
+		expected string
+	}{
+		{"unknown player", BoardPosition{PlayerIndex: 2, Position: 0}, "invalid player index: 2"},
+		{"outside the board", BoardPosition{PlayerIndex: PLAYER_B, Position: 5}, "invalid card index position: 5"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			positions := []BoardPosition{{PlayerIndex: PLAYER_B, Position: 0}, test.slot}
+			err := EventBulkCardStateAndPositionChangedFn(game, &BulkCardStateAndPositionChangedPayload{Positions: positions, IsInAttackMode: &toDefense})
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.expected)
+			assert.True(t, monster.Card.IsInAttackMode, "no monster is changed when a slot is invalid")
+		})
+	}
+
+	// to see this error message, run the test with -v flag
+	err := EventBulkCardStateAndPositionChangedFn(game, &BulkCardStateAndPositionChangedPayload{Positions: []BoardPosition{{PlayerIndex: -1}}})
+	t.Logf("Error: %v", err)
+}
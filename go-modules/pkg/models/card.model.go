@@ -3,8 +3,6 @@ package models
 import (
 	"fmt"
 	"sync"
-
-	"gopkg.in/yaml.v3"
 )
 
 // represents the rarity level of a card
@@ -28,19 +26,19 @@ const (
 	TypeRitual       TypeCard = "Ritual"
 	TypeTrap         TypeCard = "Trap"
 	TypeZombie       TypeCard = "Zombie"
-	TypeSpellCaster  TypeCard = "SpellCaster"
+	TypeSpellCaster  TypeCard = "Spellcaster"
 	TypeFiend        TypeCard = "Fiend"
 	TypePlant        TypeCard = "Plant"
 	TypeRock         TypeCard = "Rock"
-	TypeInsect       TypeCard = "Inscect"
+	TypeInsect       TypeCard = "Insect"
 	TypeAqua         TypeCard = "Aqua"
-	TypeFairy        TypeCard = "Faity"
+	TypeFairy        TypeCard = "Fairy"
 	TypeMachine      TypeCard = "Machine"
 	TypeWarrior      TypeCard = "Warrior"
 	TypeBeast        TypeCard = "Beast"
-	TypeReptile      TypeCard = "Repitle"
+	TypeReptile      TypeCard = "Reptile"
 	TypePyro         TypeCard = "Pyro"
-	TypeDinasour     TypeCard = "Dinasour"
+	TypeDinosaur     TypeCard = "Dinosaur"
 	TypeDragon       TypeCard = "Dragon"
 	TypeThunder      TypeCard = "Thunder"
 	TypeWingedBeast  TypeCard = "Winged Beast"
@@ -63,7 +61,7 @@ var validMonsterTypes = map[TypeCard]bool{
 	TypeBeast:        true,
 	TypeReptile:      true,
 	TypePyro:         true,
-	TypeDinasour:     true,
+	TypeDinosaur:     true,
 	TypeDragon:       true,
 	TypeThunder:      true,
 	TypeWingedBeast:  true,
@@ -78,25 +76,27 @@ type EquipRules struct {
 	Bonus          int   `yaml:"bonus"`          // Bonus applied to both ATK and DEF
 }
 
+// defines the monsters sacrificed by a ritual card and the monster it summons
 type RitualRules struct {
-	Material         []int `yaml:"materialIDs"`
-	IsRitualResult   bool  `yaml:"isRitualResult"`
-	IsRitualMaterial bool  `yaml:"isRitualMaterial"`
+	Material []int `yaml:"materialIDs"`
+	ResultID int   `yaml:"resultID"`
 }
 
 // contains the immutable properties of a card
 type CardTemplate struct {
-	ID            int          `yaml:"id"`
-	Name          string       `yaml:"name"`
-	Description   string       `yaml:"description"`
-	BaseAttack    int          `yaml:"baseAttack"`
-	BaseDefense   int          `yaml:"baseDefense"`
-	Level         int          `yaml:"level"`
-	Type          TypeCard     `yaml:"type"`
-	GuardianStars []string     `yaml:"guardianStars"` // slices initialize to nil instead of {"", ""}
-	Rarity        Rarity       `yaml:"rarity"`
-	EquipRules    *EquipRules  `yaml:"equipRules,omitempty"`
-	RitualRules   *RitualRules `yaml:"ritualRules,omitempty"`
+	ID            int            `yaml:"id"`
+	Name          string         `yaml:"name"`
+	Description   string         `yaml:"description"`
+	BaseAttack    int            `yaml:"baseAttack"`
+	BaseDefense   int            `yaml:"baseDefense"`
+	Level         int            `yaml:"level"`
+	Type          TypeCard       `yaml:"type"`
+	GuardianStars []GuardianStar `yaml:"guardianStars"` // slices initialize to nil instead of {"", ""}
+	Rarity        Rarity         `yaml:"rarity"`
+	EquipRules    *EquipRules    `yaml:"equipRules,omitempty"`
+	RitualRules   *RitualRules   `yaml:"ritualRules,omitempty"`
+	TrapRules     *TrapRules     `yaml:"trapRules,omitempty"`
+	MagicEffects  []*MagicEffect `yaml:"magicEffects,omitempty"`
 }
 
 // represents a card in play
@@ -110,6 +110,7 @@ type CardInstance struct {
 // is the global registry of card templates
 type CardRegistry struct {
 	templates map[int]*CardTemplate
+	index     *cardIndex // rebuilt every time cards are loaded
 }
 
 var (
@@ -130,20 +131,23 @@ func GetCardRegistry() *CardRegistry {
 	singletonForRegistry.Do(func() {
 		registry = &CardRegistry{
 			templates: make(map[int]*CardTemplate),
+			index:     newCardIndex(nil),
 		}
 	})
 	return registry
 }
 
+// loads nothing unless every card is valid, the problems are returned as a *CardValidationReport
 func (r *CardRegistry) LoadCardsfromYAML(data []byte) error {
-	var templates []*CardTemplate
-	if err := yaml.Unmarshal(data, &templates); err != nil {
-		return fmt.Errorf("unexpected error trying to load cards from YAML data: %w", err)
+	templates, err := parseCards(data, r.templates)
+	if err != nil {
+		return err
 	}
 
 	for _, template := range templates {
 		r.templates[template.ID] = template
 	}
+	r.index = newCardIndex(r.templates)
 	fmt.Printf("Registry has a total of %d cards loaded", len(r.templates))
 	return nil
 }
@@ -38,7 +38,7 @@ func InitializeCardRegistryWithFakeYAMLData() {
   type: "Equip"
   rarity: "NORMAL"
   equipRules:
-    validTargetIDs: [33]
+    validTargetIDs: [1001]
     bonus: 700
 `
 	GetCardRegistry().LoadCardsfromYAML([]byte(data))
@@ -114,6 +114,6 @@ func TestEquipCard(t *testing.T) {
 	assert.Equal(t, equipCard.Type, TypeEquip)
 	assert.NotNil(t, equipCard.EquipRules)
 	assert.Equal(t, 1, len(equipCard.EquipRules.ValidTargetIDs))
-	assert.Equal(t, 33, equipCard.EquipRules.ValidTargetIDs[0])
+	assert.Equal(t, 1001, equipCard.EquipRules.ValidTargetIDs[0])
 	assert.Equal(t, 700, equipCard.EquipRules.Bonus)
 }
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+type CardFusedPayload struct {
+	PlayerIndex int // set once the fusion chain is played
+	MaterialIDs [2]int
+	ResultID    int
+}
+
+func (*CardFusedPayload) EventType() EventType { return EventCardFused }
+func (p *CardFusedPayload) player() int        { return p.PlayerIndex }
+
+func EventCardFusedFn(game *Game, payload *CardFusedPayload) error {
+	first := GetCardRegistry().GetCard(payload.MaterialIDs[0])
+	second := GetCardRegistry().GetCard(payload.MaterialIDs[1])
+	if first == nil || second == nil {
+		return errors.New("fusion materials missing")
+	}
+	result := GetCardRegistry().GetCard(payload.ResultID)
+	if result == nil {
+		return errors.New("fusion result missing")
+	}
+
+	fmt.Printf("Fusing %s and %s into %s...\n", first.Name, second.Name, result.Name)
+	return nil
+}
+
+// the result is placed face-down, so the opponent does not learn what was fused
+func (p *CardFusedPayload) redactFor(playerIndex int) Payload {
+	if playerIndex == p.PlayerIndex {
+		return p
+	}
+	return &CardFusedPayload{PlayerIndex: p.PlayerIndex}
+}
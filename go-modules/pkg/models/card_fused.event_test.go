@@ -0,0 +1,26 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvalidEventCardFusedFn(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+
+	err := EventCardFusedFn(nil, &CardFusedPayload{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "fusion materials missing")
+
+	err = EventCardFusedFn(nil, &CardFusedPayload{MaterialIDs: [2]int{38, 39}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "fusion result missing")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+
+	err = EventCardFusedFn(nil, &CardFusedPayload{MaterialIDs: [2]int{38, 39}, ResultID: 37})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+type CardFusionFailedPayload struct {
+	PlayerIndex int // set once the fusion chain is played
+	MaterialIDs [2]int
+	DiscardedID int
+}
+
+func (*CardFusionFailedPayload) EventType() EventType { return EventCardFusionFailed }
+func (p *CardFusionFailedPayload) player() int        { return p.PlayerIndex }
+
+func EventCardFusionFailedFn(game *Game, payload *CardFusionFailedPayload) error {
+	first := GetCardRegistry().GetCard(payload.MaterialIDs[0])
+	second := GetCardRegistry().GetCard(payload.MaterialIDs[1])
+	if first == nil || second == nil {
+		return errors.New("fusion materials missing")
+	}
+	discarded := GetCardRegistry().GetCard(payload.DiscardedID)
+	if discarded == nil {
+		return errors.New("discarded card missing")
+	}
+
+	fmt.Printf("Fusion of %s and %s failed, discarding %s...\n", first.Name, second.Name, discarded.Name)
+	return nil
+}
+
+// the opponent only learns that a fusion of the chain failed
+func (p *CardFusionFailedPayload) redactFor(playerIndex int) Payload {
+	if playerIndex == p.PlayerIndex {
+		return p
+	}
+	return &CardFusionFailedPayload{PlayerIndex: p.PlayerIndex}
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvalidEventCardFusionFailedFn(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+
+	err := EventCardFusionFailedFn(nil, &CardFusionFailedPayload{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "fusion materials missing")
+
+	err = EventCardFusionFailedFn(nil, &CardFusionFailedPayload{MaterialIDs: [2]int{2, 4}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "discarded card missing")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+
+	err = EventCardFusionFailedFn(nil, &CardFusionFailedPayload{MaterialIDs: [2]int{2, 4}, DiscardedID: 2})
+	assert.NoError(t, err)
+}
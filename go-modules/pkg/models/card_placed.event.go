@@ -0,0 +1,135 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var errOneCardPerTurn = errors.New("only one card can be placed per turn")
+
+type CardPlacedPayload struct {
+	PlayerIndex    int
+	HandIndexes    []int // several hand cards are fused in this order and the result is placed
+	Position       int   // a monster placed on another monster of the player is fused with it first
+	IsInAttackMode bool
+	GuardianStar   GuardianStar // the first guardian star of the monster when empty
+}
+
+func (*CardPlacedPayload) EventType() EventType { return EventCardPlaced }
+func (p *CardPlacedPayload) player() int        { return p.PlayerIndex }
+
+func EventCardPlacedFn(game *Game, payload *CardPlacedPayload) error {
+	if err := game.checkCardPlay(payload.PlayerIndex, "place a card"); err != nil {
+		return err
+	}
+	deck := game.Decks[payload.PlayerIndex]
+	hand := slices.Clone(deck.HandCards)
+	card, fusionEvents, err := Fuse(hand, payload.HandIndexes)
+	if err != nil {
+		return err
+	}
+	if payload.Position < 0 || payload.Position >= 5 {
+		return fmt.Errorf("invalid card index position: %d", payload.Position)
+	}
+
+	// an equip card placed on a monster it can power up equips it where it stands, as
+	// equipping it does, otherwise it goes to the magic and trap zone like any spell
+	occupant := game.Board.MonsterZones[payload.PlayerIndex][payload.Position]
+	if occupant != nil && CanEquip(card, occupant.Card) == nil {
+		equipEvent, err := NewEvent(&EquipCardAttachedPayload{
+			PlayerIndex: payload.PlayerIndex,
+			EquipID:     card.Template.ID,
+			TargetID:    occupant.Card.Template.ID,
+			Position:    payload.Position,
+		})
+		if err != nil {
+			return err
+		}
+		if err := game.consumeMaterials(payload.PlayerIndex, hand, payload.HandIndexes, card, fusionEvents); err != nil {
+			return err
+		}
+		return game.dispatch(equipEvent)
+	}
+
+	// the monster on the board is the first material of the chain, as the PS1 game does
+	if occupant != nil && validMonsterTypes[card.Template.Type] {
+		hand = append(hand, occupant.Card)
+		order := append([]int{len(hand) - 1}, payload.HandIndexes...)
+		if card, fusionEvents, err = Fuse(hand, order); err != nil {
+			return err
+		}
+	} else {
+		occupant = nil
+	}
+
+	zone := &game.Board.MagicTrapZones[payload.PlayerIndex]
+	if validMonsterTypes[card.Template.Type] {
+		zone = &game.Board.MonsterZones[payload.PlayerIndex]
+		if payload.GuardianStar != "" && !slices.Contains(card.Template.GuardianStars, payload.GuardianStar) {
+			return fmt.Errorf("invalid guardian star %q: expected one of [%v]", payload.GuardianStar, card.Template.GuardianStars)
+		}
+	}
+	if taken := zone[payload.Position]; taken != nil && taken != occupant {
+		return fmt.Errorf("position %d is already taken", payload.Position)
+	}
+
+	if err := game.consumeMaterials(payload.PlayerIndex, hand, payload.HandIndexes, card, fusionEvents); err != nil {
+		return err
+	}
+	if occupant != nil {
+		game.destroyMonster(payload.PlayerIndex, payload.Position)
+	}
+	deck.HandCards = slices.DeleteFunc(deck.HandCards, func(other *CardInstance) bool { return other == card })
+
+	// placed cards start face-down until they attack, are attacked or change their position
+	card.IsInAttackMode = payload.IsInAttackMode
+	state := &CardState{Card: card, IndexPosition: payload.Position, GuardianStar: payload.GuardianStar}
+	if err := game.Board.SetCardAtIndexPosition(state, payload.PlayerIndex); err != nil {
+		return err
+	}
+	deck.ActiveCardsOnBoard = append(deck.ActiveCardsOnBoard, card)
+	game.CurrentTurn.CardPlaced = true
+	fmt.Printf("%s places %s at position %d...\n", deck.Player.Username, card.Template.Name, payload.Position)
+	if validMonsterTypes[card.Template.Type] {
+		return game.fireTraps(TriggerMonsterSummoned, (payload.PlayerIndex+1)%2, payload.Position)
+	}
+	return nil
+}
+
+// the opponent does not learn which cards of the hand were played nor the guardian star
+// of the card, which is placed face-down
+func (p *CardPlacedPayload) redactFor(playerIndex int) Payload {
+	if playerIndex == p.PlayerIndex {
+		return p
+	}
+	redacted := *p
+	redacted.HandIndexes = nil
+	redacted.GuardianStar = ""
+	return &redacted
+}
+
+// dispatches the events of the fusion chain on behalf of the player and consumes its
+// materials, the card that comes out of it stays in the hand until it is played
+func (g *Game) consumeMaterials(playerIndex int, hand []*CardInstance, handIndexes []int, card *CardInstance, fusionEvents []*Event) error {
+	deck := g.Decks[playerIndex]
+	for _, event := range fusionEvents {
+		switch payload := event.Payload.(type) {
+		case *CardFusedPayload:
+			payload.PlayerIndex = playerIndex
+		case *CardFusionFailedPayload:
+			payload.PlayerIndex = playerIndex
+		case *EquipCardAttachedPayload:
+			payload.PlayerIndex = playerIndex
+		}
+		if err := g.dispatch(event); err != nil {
+			return err
+		}
+	}
+	for _, index := range handIndexes {
+		if material := hand[index]; material != card {
+			deck.DestroyCard(material)
+		}
+	}
+	return nil
+}
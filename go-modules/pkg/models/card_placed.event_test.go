@@ -0,0 +1,119 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventCardPlacedFnPlacesSpellsInTheMagicZone(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	game := newGameInActionPhase()
+	game.CurrentTurn.Phase = PlaceCardsPhase
+	deck := game.Decks[PLAYER_A]
+	deck.HandCards = newHand(t, 301) // Legendary Sword
+	monster := newMonsterState(1000, 1000, true)
+	placeMonster(game, PLAYER_A, 4, monster)
+
+	// spells do not fuse with the monster in front of them
+	err := EventCardPlacedFn(game, &CardPlacedPayload{PlayerIndex: PLAYER_A, HandIndexes: []int{0}, Position: 4})
+	assert.NoError(t, err)
+	assert.Equal(t, 301, game.Board.MagicTrapZones[PLAYER_A][4].Card.Template.ID)
+	assert.False(t, game.Board.MagicTrapZones[PLAYER_A][4].FaceUp)
+	assert.Same(t, monster, game.Board.MonsterZones[PLAYER_A][4])
+	assert.Empty(t, deck.HandCards)
+	assert.Empty(t, deck.DestroyedCards)
+	assert.True(t, game.CurrentTurn.CardPlaced)
+}
+
+func TestEventCardPlacedFnFusesWithTheMonsterOnTheBoard(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	LoadRealFusionsFromYAML()
+	game := newGameInActionPhase()
+	game.CurrentTurn.Phase = PlaceCardsPhase
+	deck := game.Decks[PLAYER_A]
+	gaia := &CardState{Card: newHand(t, 38)[0], FaceUp: true} // Gaia the Fierce Knight
+	placeMonster(game, PLAYER_A, 1, gaia)
+	deck.HandCards = newHand(t, 39, 4) // Curse of Dragon and Baby Dragon
+
+	err := EventCardPlacedFn(game, &CardPlacedPayload{PlayerIndex: PLAYER_A, HandIndexes: []int{0}, Position: 1, IsInAttackMode: true})
+	assert.NoError(t, err)
+	champion := game.Board.MonsterZones[PLAYER_A][1]
+	assert.Equal(t, 37, champion.Card.Template.ID)
+	assert.True(t, champion.Card.IsInAttackMode)
+	assert.Equal(t, []*CardInstance{champion.Card}, deck.ActiveCardsOnBoard)
+	assert.Equal(t, []int{39, 38}, []int{deck.DestroyedCards[0].Template.ID, deck.DestroyedCards[1].Template.ID})
+	assert.Equal(t, 4, deck.HandCards[0].Template.ID)
+	assert.Len(t, deck.HandCards, 1)
+}
+
+func TestEventCardPlacedFnEquipsTheMonsterOnTheBoard(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	game := newGameInActionPhase()
+	game.CurrentTurn.Phase = PlaceCardsPhase
+	deck := game.Decks[PLAYER_A]
+	game.Board.ChangeTerrain(TerrainSogen)
+	flameSwordsman := &CardState{Card: newHand(t, 15)[0]}
+	assert.NoError(t, game.Board.SetCardAtIndexPosition(flameSwordsman, PLAYER_A))
+	deck.ActiveCardsOnBoard = []*CardInstance{flameSwordsman.Card}
+	deck.HandCards = newHand(t, 301) // Legendary Sword
+	sword := deck.HandCards[0]
+
+	// the terrain bonus is kept once and the sword adds its own
+	err := EventCardPlacedFn(game, &CardPlacedPayload{PlayerIndex: PLAYER_A, HandIndexes: []int{0}, Position: 0})
+	assert.NoError(t, err)
+	assert.Same(t, flameSwordsman, game.Board.MonsterZones[PLAYER_A][0])
+	assert.Equal(t, 1800+500+500, flameSwordsman.Card.CurrentAttack)
+	assert.Equal(t, 1600+500+500, flameSwordsman.Card.CurrentDefense)
+	assert.Nil(t, game.Board.MagicTrapZones[PLAYER_A][0])
+	assert.Equal(t, []*CardInstance{flameSwordsman.Card}, deck.ActiveCardsOnBoard)
+	assert.Equal(t, []*CardInstance{sword}, deck.DestroyedCards)
+	assert.Empty(t, deck.HandCards)
+	assert.True(t, game.CurrentTurn.CardPlaced)
+
+}
+
+func TestInvalidEventCardPlacedFn(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	game := newGameInActionPhase()
+	game.CurrentTurn.Phase = PlaceCardsPhase
+	game.Decks[PLAYER_A].HandCards = newHand(t, 4) // Baby Dragon
+
+	tests := []struct {
+		name     string
+		payload  *CardPlacedPayload
+		expected string
+	}{
+		{"no cards", &CardPlacedPayload{}, "at least one card must be selected"},
+		{"not in the hand", &CardPlacedPayload{HandIndexes: []int{1}}, "invalid hand index: 1"},
+		{"outside the board", &CardPlacedPayload{HandIndexes: []int{0}, Position: 5}, "invalid card index position: 5"},
+		{"foreign guardian star", &CardPlacedPayload{HandIndexes: []int{0}, GuardianStar: GuardianStarMoon}, `invalid guardian star "Moon"`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := EventCardPlacedFn(game, test.payload)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.expected)
+		})
+	}
+
+	game.Decks[PLAYER_A].HandCards = newHand(t, 4, 301) // Baby Dragon and Legendary Sword
+	game.Board.MagicTrapZones[PLAYER_A][0] = &CardState{Card: newHand(t, 302)[0]}
+	err := EventCardPlacedFn(game, &CardPlacedPayload{HandIndexes: []int{1}, Position: 0})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "position 0 is already taken")
+	assert.Len(t, game.Decks[PLAYER_A].HandCards, 2)
+
+	assert.NoError(t, EventCardPlacedFn(game, &CardPlacedPayload{HandIndexes: []int{0}, Position: 0}))
+	err = EventCardPlacedFn(game, &CardPlacedPayload{HandIndexes: []int{0}, Position: 1})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "only one card can be placed per turn")
+	assert.Len(t, game.Decks[PLAYER_A].HandCards, 1)
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
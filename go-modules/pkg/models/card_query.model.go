@@ -0,0 +1,282 @@
+package models
+
+import (
+	"math/bits"
+	"slices"
+	"sort"
+	"strings"
+)
+
+type CardSortField string
+
+const (
+	SortByID      CardSortField = "ID"
+	SortByName    CardSortField = "NAME"
+	SortByLevel   CardSortField = "LEVEL"
+	SortByAttack  CardSortField = "ATTACK"
+	SortByDefense CardSortField = "DEFENSE"
+)
+
+var numericCardFields = map[CardSortField]func(template *CardTemplate) int{
+	SortByID:      func(template *CardTemplate) int { return template.ID },
+	SortByLevel:   func(template *CardTemplate) int { return template.Level },
+	SortByAttack:  func(template *CardTemplate) int { return template.BaseAttack },
+	SortByDefense: func(template *CardTemplate) int { return template.BaseDefense },
+}
+
+// a set of positions of cardIndex.templates, one bit per template
+type bitset []uint64
+
+func newBitset(size int) bitset {
+	return make(bitset, (size+63)/64)
+}
+
+func fullBitset(size int) bitset {
+	set := newBitset(size)
+	for position := range size {
+		set.add(position)
+	}
+	return set
+}
+
+func (s bitset) add(position int) {
+	s[position/64] |= 1 << (position % 64)
+}
+
+func (s bitset) has(position int) bool {
+	return s[position/64]&(1<<(position%64)) != 0
+}
+
+func (s bitset) remove(position int) {
+	s[position/64] &^= 1 << (position % 64)
+}
+
+func (s bitset) unite(other bitset) {
+	for word := range s {
+		s[word] |= other[word]
+	}
+}
+
+func (s bitset) intersect(other bitset) {
+	for word := range s {
+		s[word] &= other[word]
+	}
+}
+
+func (s bitset) count() int {
+	total := 0
+	for _, word := range s {
+		total += bits.OnesCount64(word)
+	}
+	return total
+}
+
+// precomputed when the cards are loaded so the queries never scan the templates
+type cardIndex struct {
+	templates []*CardTemplate // by ID
+	names     []string        // lowercase, same positions as templates
+	byType    map[TypeCard]bitset
+	byRarity  map[Rarity]bitset
+	byStar    map[GuardianStar]bitset
+	orders    map[CardSortField][]int // positions sorted by each field, ties broken by ID
+}
+
+func newCardIndex(templates map[int]*CardTemplate) *cardIndex {
+	index := &cardIndex{
+		byType:   make(map[TypeCard]bitset),
+		byRarity: make(map[Rarity]bitset),
+		byStar:   make(map[GuardianStar]bitset),
+		orders:   make(map[CardSortField][]int),
+	}
+	for _, template := range templates {
+		index.templates = append(index.templates, template)
+	}
+	sort.Slice(index.templates, func(i, j int) bool { return index.templates[i].ID < index.templates[j].ID })
+
+	size := len(index.templates)
+	for position, template := range index.templates {
+		index.names = append(index.names, strings.ToLower(template.Name))
+		if index.byType[template.Type] == nil {
+			index.byType[template.Type] = newBitset(size)
+		}
+		index.byType[template.Type].add(position)
+		if index.byRarity[template.Rarity] == nil {
+			index.byRarity[template.Rarity] = newBitset(size)
+		}
+		index.byRarity[template.Rarity].add(position)
+		for _, star := range template.GuardianStars {
+			if index.byStar[star] == nil {
+				index.byStar[star] = newBitset(size)
+			}
+			index.byStar[star].add(position)
+		}
+	}
+
+	for field, value := range numericCardFields {
+		index.orders[field] = index.sortedPositions(func(a, b *CardTemplate) int { return value(a) - value(b) })
+	}
+	index.orders[SortByName] = index.sortedPositions(func(a, b *CardTemplate) int { return strings.Compare(a.Name, b.Name) })
+	return index
+}
+
+func (i *cardIndex) sortedPositions(compare func(a, b *CardTemplate) int) []int {
+	positions := make([]int, len(i.templates))
+	for position := range positions {
+		positions[position] = position
+	}
+	// templates are sorted by ID, a stable sort keeps the ID order on ties
+	slices.SortStableFunc(positions, func(a, b int) int { return compare(i.templates[a], i.templates[b]) })
+	return positions
+}
+
+// the positions whose field is within [low, high], found by binary search over the sorted positions
+func (i *cardIndex) between(field CardSortField, low, high int) bitset {
+	order, value := i.orders[field], numericCardFields[field]
+	first := sort.Search(len(order), func(k int) bool { return value(i.templates[order[k]]) >= low })
+	last := sort.Search(len(order), func(k int) bool { return value(i.templates[order[k]]) > high })
+	set := newBitset(len(i.templates))
+	for _, position := range order[first:max(first, last)] {
+		set.add(position)
+	}
+	return set
+}
+
+// filters, sorts and paginates the card templates of the registry, every filter narrows the results:
+//
+//	GetCardRegistry().Query().Types(TypeDragon).AttackBetween(2000, 3000).SortBy(SortByAttack, true).Page(0, 10).Results()
+type CardQuery struct {
+	index      *cardIndex
+	filters    []bitset
+	name       string
+	sortField  CardSortField
+	descending bool
+	offset     int
+	limit      int
+}
+
+func (r *CardRegistry) Query() *CardQuery {
+	return &CardQuery{index: r.index, sortField: SortByID}
+}
+
+// keeps the cards of any of the given types
+func (q *CardQuery) Types(types ...TypeCard) *CardQuery {
+	set := newBitset(len(q.index.templates))
+	for _, typeCard := range types {
+		if typeSet, exists := q.index.byType[typeCard]; exists {
+			set.unite(typeSet)
+		}
+	}
+	q.filters = append(q.filters, set)
+	return q
+}
+
+// keeps the cards of any of the given rarities
+func (q *CardQuery) Rarities(rarities ...Rarity) *CardQuery {
+	set := newBitset(len(q.index.templates))
+	for _, rarity := range rarities {
+		if raritySet, exists := q.index.byRarity[rarity]; exists {
+			set.unite(raritySet)
+		}
+	}
+	q.filters = append(q.filters, set)
+	return q
+}
+
+// keeps the cards having any of the given guardian stars
+func (q *CardQuery) GuardianStars(stars ...GuardianStar) *CardQuery {
+	set := newBitset(len(q.index.templates))
+	for _, star := range stars {
+		if starSet, exists := q.index.byStar[star]; exists {
+			set.unite(starSet)
+		}
+	}
+	q.filters = append(q.filters, set)
+	return q
+}
+
+// both bounds are inclusive
+func (q *CardQuery) LevelBetween(low, high int) *CardQuery {
+	q.filters = append(q.filters, q.index.between(SortByLevel, low, high))
+	return q
+}
+
+// both bounds are inclusive
+func (q *CardQuery) AttackBetween(low, high int) *CardQuery {
+	q.filters = append(q.filters, q.index.between(SortByAttack, low, high))
+	return q
+}
+
+// both bounds are inclusive
+func (q *CardQuery) DefenseBetween(low, high int) *CardQuery {
+	q.filters = append(q.filters, q.index.between(SortByDefense, low, high))
+	return q
+}
+
+// case insensitive
+func (q *CardQuery) NameContains(name string) *CardQuery {
+	q.name = strings.ToLower(name)
+	return q
+}
+
+// the results are sorted by ID unless another field is given, ties are sorted by ID in the same direction
+func (q *CardQuery) SortBy(field CardSortField, descending bool) *CardQuery {
+	q.sortField = field
+	q.descending = descending
+	return q
+}
+
+// skips offset results and returns at most limit of them, a limit of 0 returns all of them
+func (q *CardQuery) Page(offset, limit int) *CardQuery {
+	q.offset = offset
+	q.limit = limit
+	return q
+}
+
+func (q *CardQuery) matches() bitset {
+	set := fullBitset(len(q.index.templates))
+	for _, filter := range q.filters {
+		set.intersect(filter)
+	}
+	if q.name != "" {
+		for position, name := range q.index.names {
+			if set.has(position) && !strings.Contains(name, q.name) {
+				set.remove(position)
+			}
+		}
+	}
+	return set
+}
+
+// number of matching cards, regardless of the page
+func (q *CardQuery) Count() int {
+	return q.matches().count()
+}
+
+func (q *CardQuery) Results() []*CardTemplate {
+	set := q.matches()
+	order, exists := q.index.orders[q.sortField]
+	if !exists {
+		order = q.index.orders[SortByID]
+	}
+
+	results := []*CardTemplate{}
+	skipped := 0
+	for k := range order {
+		if q.descending {
+			k = len(order) - 1 - k
+		}
+		position := order[k]
+		if !set.has(position) {
+			continue
+		}
+		if skipped < q.offset {
+			skipped++
+			continue
+		}
+		results = append(results, q.index.templates[position])
+		if q.limit > 0 && len(results) == q.limit {
+			break
+		}
+	}
+	return results
+}
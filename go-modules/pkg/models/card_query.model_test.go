@@ -0,0 +1,87 @@
+package models
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// a registry of its own so the cards loaded by other tests do not change the results
+func newRegistryWithReal722Cards(t testing.TB) *CardRegistry {
+	data, err := os.ReadFile("../utils/cards.yaml")
+	assert.NoError(t, err)
+	registry := &CardRegistry{templates: make(map[int]*CardTemplate), index: newCardIndex(nil)}
+	assert.NoError(t, registry.LoadCardsfromYAML(data))
+	return registry
+}
+
+func TestQueryFiltersTheCards(t *testing.T) {
+	registry := newRegistryWithReal722Cards(t)
+
+	assert.Equal(t, 722, registry.Query().Count())
+	assert.Equal(t, 32, registry.Query().Types(TypeDragon).Count())
+	assert.Equal(t, 73+32, registry.Query().Types(TypeDragon, TypeWarrior).Count())
+	assert.Zero(t, registry.Query().Rarities(RarityGhost).Count())
+
+	dragons := registry.Query().Types(TypeDragon).AttackBetween(2500, 3000).GuardianStars(GuardianStarSun).NameContains("DRAGON").Results()
+	assert.NotEmpty(t, dragons)
+	for _, dragon := range dragons {
+		assert.Equal(t, TypeDragon, dragon.Type)
+		assert.GreaterOrEqual(t, dragon.BaseAttack, 2500)
+		assert.LessOrEqual(t, dragon.BaseAttack, 3000)
+		assert.Contains(t, dragon.GuardianStars, GuardianStarSun)
+		assert.Contains(t, strings.ToLower(dragon.Name), "dragon")
+	}
+	assert.Equal(t, 1, dragons[0].ID, "sorted by ID by default")
+
+	// the indexes give the same answer as scanning every template
+	query := registry.Query().LevelBetween(3, 5).DefenseBetween(1000, 1500).Types(TypeWarrior, TypeBeast, TypeFiend)
+	expected := []int{}
+	for _, template := range registry.Query().Results() {
+		if template.Level >= 3 && template.Level <= 5 && template.BaseDefense >= 1000 && template.BaseDefense <= 1500 &&
+			slices.Contains([]TypeCard{TypeWarrior, TypeBeast, TypeFiend}, template.Type) {
+			expected = append(expected, template.ID)
+		}
+	}
+	assert.NotEmpty(t, expected)
+	found := []int{}
+	for _, template := range query.Results() {
+		found = append(found, template.ID)
+	}
+	assert.Equal(t, expected, found)
+
+	assert.Empty(t, registry.Query().AttackBetween(3000, 2000).Results(), "an empty range matches nothing")
+}
+
+func TestQuerySortsAndPaginates(t *testing.T) {
+	registry := newRegistryWithReal722Cards(t)
+
+	strongest := registry.Query().SortBy(SortByAttack, true).Page(0, 3).Results()
+	assert.Len(t, strongest, 3)
+	assert.Equal(t, "Blue-eyes Ultimate Dragon", strongest[0].Name)
+	assert.GreaterOrEqual(t, strongest[1].BaseAttack, strongest[2].BaseAttack)
+
+	byName := registry.Query().Types(TypeDragon).SortBy(SortByName, false).Results()
+	assert.True(t, slices.IsSortedFunc(byName, func(a, b *CardTemplate) int { return strings.Compare(a.Name, b.Name) }))
+
+	// the pages cover the results without gaps nor repetitions
+	all := registry.Query().Types(TypeDragon).SortBy(SortByDefense, false).Results()
+	pages := []*CardTemplate{}
+	for offset := 0; offset < len(all); offset += 10 {
+		pages = append(pages, registry.Query().Types(TypeDragon).SortBy(SortByDefense, false).Page(offset, 10).Results()...)
+	}
+	assert.Equal(t, all, pages)
+	assert.Empty(t, registry.Query().Types(TypeDragon).Page(len(all), 10).Results())
+	assert.Equal(t, len(all), registry.Query().Types(TypeDragon).Page(0, 5).Count(), "the count ignores the page")
+}
+
+func BenchmarkQuery(b *testing.B) {
+	registry := newRegistryWithReal722Cards(b)
+	for range b.N {
+		registry.Query().Types(TypeDragon, TypeWarrior).LevelBetween(4, 7).AttackBetween(1500, 3000).
+			NameContains("dragon").SortBy(SortByAttack, true).Page(0, 20).Results()
+	}
+}
@@ -0,0 +1,185 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const MaxCardLevel = 12
+
+var validSpellTypes = map[TypeCard]bool{
+	TypeEquip:  true,
+	TypeMagic:  true,
+	TypeRitual: true,
+	TypeTrap:   true,
+}
+
+var validRarities = map[Rarity]bool{
+	RarityNormal:     true,
+	RarityRare:       true,
+	RaritySuperRare:  true,
+	RarityUltraRare:  true,
+	RaritySecretRare: true,
+	RarityUltimate:   true,
+	RarityGhost:      true,
+}
+
+// a problem found in one card, CardID is 0 when the card could not be identified
+type CardValidationError struct {
+	CardID  int
+	Problem string
+}
+
+func (e *CardValidationError) Error() string {
+	if e.CardID == 0 {
+		return e.Problem
+	}
+	return fmt.Sprintf("card %d: %s", e.CardID, e.Problem)
+}
+
+// every problem found in a set of cards, none of them is loaded when there is at least one
+type CardValidationReport struct {
+	Errors []*CardValidationError
+}
+
+func (r *CardValidationReport) Error() string {
+	lines := []string{fmt.Sprintf("%d problems found in the cards:", len(r.Errors))}
+	for _, err := range r.Errors {
+		lines = append(lines, err.Error())
+	}
+	return strings.Join(lines, "\n  ")
+}
+
+func (r *CardValidationReport) Unwrap() []error {
+	errs := make([]error, len(r.Errors))
+	for index, err := range r.Errors {
+		errs[index] = err
+	}
+	return errs
+}
+
+func (r *CardValidationReport) add(cardID int, format string, args ...any) {
+	r.Errors = append(r.Errors, &CardValidationError{CardID: cardID, Problem: fmt.Sprintf(format, args...)})
+}
+
+// the monster and stats fields a card declares, to tell a missing stat from a stat of 0
+type declaredStats struct {
+	BaseAttack  *int `yaml:"baseAttack"`
+	BaseDefense *int `yaml:"baseDefense"`
+	Level       *int `yaml:"level"`
+}
+
+// decodes the cards and checks them against each other and against the cards already known,
+// the types are normalized so "spellcaster" or "SPELLCASTER" become TypeSpellCaster
+func parseCards(data []byte, known map[int]*CardTemplate) ([]*CardTemplate, error) {
+	report := &CardValidationReport{}
+	var templates []*CardTemplate
+	// type errors do not stop the decoding, they are reported without the card they belong to
+	if err := yaml.Unmarshal(data, &templates); err != nil {
+		var typeErr *yaml.TypeError
+		if !errors.As(err, &typeErr) {
+			return nil, fmt.Errorf("unexpected error trying to load cards from YAML data: %w", err)
+		}
+		for _, problem := range typeErr.Errors {
+			report.add(0, "%s", problem)
+		}
+	}
+	var stats []declaredStats
+	if err := yaml.Unmarshal(data, &stats); err != nil {
+		return nil, fmt.Errorf("unexpected error trying to load cards from YAML data: %w", err)
+	}
+
+	byID := map[int]*CardTemplate{}
+	for _, template := range templates {
+		if _, duplicated := byID[template.ID]; duplicated {
+			report.add(template.ID, "duplicated ID")
+		}
+		byID[template.ID] = template
+	}
+	exists := func(id int) bool { return byID[id] != nil || known[id] != nil }
+
+	for index, template := range templates {
+		validateCard(report, template, stats[index], exists)
+	}
+	if len(report.Errors) > 0 {
+		return nil, report
+	}
+	return templates, nil
+}
+
+func validateCard(report *CardValidationReport, template *CardTemplate, stats declaredStats, exists func(id int) bool) {
+	id := template.ID
+	if id <= 0 {
+		report.add(0, "card %q has an invalid ID %d", template.Name, id)
+	}
+	if template.Name == "" {
+		report.add(id, "missing name")
+	}
+	if !validRarities[template.Rarity] {
+		report.add(id, "unknown rarity %q", template.Rarity)
+	}
+
+	template.Type = normalizeTypeCard(template.Type)
+	switch {
+	case validMonsterTypes[template.Type]:
+		if stats.BaseAttack == nil || stats.BaseDefense == nil || stats.Level == nil {
+			report.add(id, "monsters must declare baseAttack, baseDefense and level")
+		}
+		if template.BaseAttack < 0 || template.BaseDefense < 0 {
+			report.add(id, "negative stats %d/%d", template.BaseAttack, template.BaseDefense)
+		}
+		if stats.Level != nil && (template.Level < 1 || template.Level > MaxCardLevel) {
+			report.add(id, "invalid level %d: expected 1 to %d", template.Level, MaxCardLevel)
+		}
+		if len(template.GuardianStars) != 2 {
+			report.add(id, "monsters must have 2 guardian stars, got %d", len(template.GuardianStars))
+		}
+	case validSpellTypes[template.Type]:
+	default:
+		report.add(id, "unknown type %q", template.Type)
+	}
+	for _, star := range template.GuardianStars {
+		if !star.IsValid() {
+			report.add(id, "invalid guardian star %q", star)
+		}
+	}
+
+	for _, effect := range template.MagicEffects {
+		if err := effect.validate(); err != nil {
+			report.add(id, "%v", err)
+		}
+	}
+	if rules := template.EquipRules; rules != nil {
+		for _, targetID := range rules.ValidTargetIDs {
+			if !exists(targetID) {
+				report.add(id, "equip target %d does not exist", targetID)
+			}
+		}
+	}
+	if rules := template.RitualRules; rules != nil {
+		for _, materialID := range rules.Material {
+			if !exists(materialID) {
+				report.add(id, "ritual material %d does not exist", materialID)
+			}
+		}
+		if rules.ResultID != 0 && !exists(rules.ResultID) {
+			report.add(id, "ritual result %d does not exist", rules.ResultID)
+		}
+	}
+}
+
+// matches the type regardless of its case and surrounding spaces, unknown types are returned as they are
+func normalizeTypeCard(typeCard TypeCard) TypeCard {
+	name := strings.TrimSpace(string(typeCard))
+	for _, types := range []map[TypeCard]bool{validMonsterTypes, validSpellTypes} {
+		for known := range types {
+			if strings.EqualFold(name, string(known)) {
+				return known
+			}
+		}
+	}
+	return typeCard
+}
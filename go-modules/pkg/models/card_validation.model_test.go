@@ -0,0 +1,102 @@
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadCardsfromYAMLNormalizesTypes(t *testing.T) {
+	registry := newRegistryWithReal722Cards(t)
+	assert.Equal(t, TypeSpellCaster, registry.GetCard(2).Type) // Mystical Elf
+	assert.Equal(t, 13, registry.Query().Types(TypeDinosaur).Count())
+	assert.Equal(t, 36, registry.Query().Types(TypeInsect).Count())
+	assert.Equal(t, 26, registry.Query().Types(TypeFairy).Count())
+	assert.Equal(t, 12, registry.Query().Types(TypeReptile).Count())
+
+	data := `
+- id: 1001
+  name: "Shouting Elf"
+  baseAttack: 800
+  baseDefense: 2000
+  level: 4
+  type: " SPELLCASTER "
+  guardianStars: ["Sun", "Jupiter"]
+  rarity: "NORMAL"
+`
+	assert.NoError(t, registry.LoadCardsfromYAML([]byte(data)))
+	assert.Equal(t, TypeSpellCaster, registry.GetCard(1001).Type)
+}
+
+func TestMysticalElfCanBePlaced(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	mysticalElf, _ := NewCardInstance(2)
+	err := NewBoard().SetCardAtIndexPosition(&CardState{Card: mysticalElf, IndexPosition: 0}, PLAYER_A)
+	assert.NoError(t, err)
+}
+
+func TestLoadCardsfromYAMLReportsEveryProblem(t *testing.T) {
+	registry := newRegistryWithReal722Cards(t)
+	data := `
+- id: 1001
+  name: "Nameless Stats"
+  type: "Warrior"
+  guardianStars: ["Mars"]
+  rarity: "NORMAL"
+- id: 1001
+  name: "Duplicated"
+  baseAttack: 100
+  baseDefense: 100
+  level: 13
+  type: "Wizard"
+  guardianStars: ["Sun", "Earth"]
+  rarity: "COMMON"
+- id: 1002
+  name: "Broken Sword"
+  type: "Equip"
+  rarity: "NORMAL"
+  equipRules:
+    validTargetIDs: [2, 9999]
+    bonus: 500
+- id: 1003
+  name: "Broken Ritual"
+  type: "Ritual"
+  rarity: "NORMAL"
+  ritualRules:
+    materialIDs: [4, 8888]
+    resultID: 7777
+`
+	err := registry.LoadCardsfromYAML([]byte(data))
+	assert.Error(t, err)
+	report := &CardValidationReport{}
+	assert.True(t, errors.As(err, &report))
+	assert.Equal(t, []string{
+		"card 1001: duplicated ID",
+		"card 1001: monsters must declare baseAttack, baseDefense and level",
+		"card 1001: monsters must have 2 guardian stars, got 1",
+		`card 1001: unknown rarity "COMMON"`,
+		`card 1001: unknown type "Wizard"`,
+		`card 1001: invalid guardian star "Earth"`,
+		"card 1002: equip target 9999 does not exist",
+		"card 1003: ritual material 8888 does not exist",
+		"card 1003: ritual result 7777 does not exist",
+	}, problems(report))
+
+	var cardErr *CardValidationError
+	assert.True(t, errors.As(err, &cardErr), "every problem can be unwrapped")
+	assert.Nil(t, registry.GetCard(1002), "nothing is loaded when a card is invalid")
+	assert.Equal(t, 722, registry.Query().Count())
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+func problems(report *CardValidationReport) []string {
+	messages := []string{}
+	for _, err := range report.Errors {
+		messages = append(messages, err.Error())
+	}
+	return messages
+}
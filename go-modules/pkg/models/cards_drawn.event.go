@@ -0,0 +1,40 @@
+package models
+
+import "fmt"
+
+type CardsDrawnPayload struct {
+	PlayerIndex int
+	Count       int
+	CardIDs     []int // template IDs of the drawn cards, filled in once resolved
+}
+
+func (*CardsDrawnPayload) EventType() EventType { return EventCardsDrawn }
+func (p *CardsDrawnPayload) player() int        { return p.PlayerIndex }
+
+func EventCardsDrawnFn(game *Game, payload *CardsDrawnPayload) error {
+	deck := game.Decks[payload.PlayerIndex]
+	if len(deck.HandCards)+payload.Count > MaxHandSize {
+		return fmt.Errorf("cannot draw %d cards with %d cards in the hand, the limit is %d", payload.Count, len(deck.HandCards), MaxHandSize)
+	}
+	if err := deck.MoveCardsFromRemainingToHand(payload.Count); err != nil {
+		return err
+	}
+	payload.CardIDs = []int{}
+	for _, card := range deck.HandCards[len(deck.HandCards)-payload.Count:] {
+		if card != nil {
+			payload.CardIDs = append(payload.CardIDs, card.Template.ID)
+		}
+	}
+	fmt.Printf("%s draws %d cards...\n", deck.Player.Username, payload.Count)
+	return nil
+}
+
+// the opponent only learns how many cards were drawn
+func (p *CardsDrawnPayload) redactFor(playerIndex int) Payload {
+	if playerIndex == p.PlayerIndex {
+		return p
+	}
+	redacted := *p
+	redacted.CardIDs = nil
+	return &redacted
+}
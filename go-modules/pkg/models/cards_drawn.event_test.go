@@ -0,0 +1,29 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventCardsDrawnFn(t *testing.T) {
+	game := newGameWithFusionInHand(t)
+	deck := game.Decks[PLAYER_B]
+	next := deck.RemainingCards[0]
+
+	payload := &CardsDrawnPayload{PlayerIndex: PLAYER_B, Count: 2}
+	err := EventCardsDrawnFn(game, payload)
+	assert.NoError(t, err)
+	assert.Len(t, deck.HandCards, 2)
+	assert.Same(t, next, deck.HandCards[0], "cards are drawn from the top of the deck")
+	assert.Equal(t, templateIDs(deck.HandCards), payload.CardIDs)
+	assert.Len(t, deck.RemainingCards, 38)
+
+	err = EventCardsDrawnFn(game, &CardsDrawnPayload{PlayerIndex: PLAYER_B, Count: 4})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot draw 4 cards with 2 cards in the hand, the limit is 5")
+	assert.Len(t, deck.HandCards, 2)
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
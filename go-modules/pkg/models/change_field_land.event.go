@@ -0,0 +1,47 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+)
+
+type ChangeFieldLandPayload struct {
+	PlayerIndex int
+	FieldCardID int // field card in the hand of the player
+}
+
+func (*ChangeFieldLandPayload) EventType() EventType { return EventChangeFieldLand }
+func (p *ChangeFieldLandPayload) player() int        { return p.PlayerIndex }
+
+func EventChangeFieldLandFn(game *Game, payload *ChangeFieldLandPayload) error {
+	if err := game.checkCardPlay(payload.PlayerIndex, "activate a field card"); err != nil {
+		return err
+	}
+	deck := game.Decks[payload.PlayerIndex]
+	fieldCard, err := deck.GetHandCard(payload.FieldCardID)
+	if err != nil {
+		return err
+	}
+	terrain, err := GetFieldCardTerrain(fieldCard)
+	if err != nil {
+		return err
+	}
+
+	// the previous field card of any player is replaced by the new one
+	for owner, state := range game.Board.FieldZone {
+		if state != nil {
+			game.Board.FieldZone[owner] = nil
+			game.Decks[owner].DestroyCard(state.Card)
+		}
+	}
+
+	fmt.Printf("Changing the field to %s...\n", terrain)
+	deck.HandCards = slices.DeleteFunc(deck.HandCards, func(card *CardInstance) bool {
+		return card == fieldCard
+	})
+	deck.ActiveCardsOnBoard = append(deck.ActiveCardsOnBoard, fieldCard)
+	game.Board.FieldZone[payload.PlayerIndex] = &CardState{Card: fieldCard, FaceUp: true}
+	game.Board.ChangeTerrain(terrain)
+	game.CurrentTurn.CardPlaced = true
+	return nil
+}
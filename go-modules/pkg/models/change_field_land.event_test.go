@@ -0,0 +1,27 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvalidEventChangeFieldLandFn(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	game := newGameInActionPhase()
+	game.CurrentTurn.Phase = PlaceCardsPhase
+
+	err := EventChangeFieldLandFn(game, &ChangeFieldLandPayload{PlayerIndex: PLAYER_A, FieldCardID: 332})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is not in the hand")
+
+	// Dark Hole is not a field card
+	game.Decks[PLAYER_A].HandCards = newHand(t, 336)
+	err = EventChangeFieldLandFn(game, &ChangeFieldLandPayload{PlayerIndex: PLAYER_A, FieldCardID: 336})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is not a field card")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
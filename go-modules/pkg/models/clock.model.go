@@ -0,0 +1,102 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// chess-style time controls of a game, a zero limit leaves its clock off
+type TimeControl struct {
+	TurnLimit   time.Duration // time a player has to finish each turn
+	GameLimit   time.Duration // time a player has for all of its turns together
+	MaxTimeouts int           // turns run out of time that forfeit the duel, 0 never forfeits
+}
+
+// the clocks of a game in progress, they move to a new turn once its event is processed,
+// they are guarded by the mutex of the game as the turn they follow
+type gameClocks struct {
+	turn      *Turn // the turn whose clock is running
+	startedAt time.Time
+	spent     [2]time.Duration // time of the finished turns of each player
+}
+
+// replaces the clock of the game, so tests decide when the time runs out
+func (g *Game) SetClock(now func() time.Time) {
+	g.clock = now
+}
+
+func (g *Game) SetTimeControl(control TimeControl) error {
+	if g.State != GameReadyToStart {
+		return fmt.Errorf("the time control can only be set in the %s state, got: %s", GameReadyToStart, g.State)
+	}
+	if control.TurnLimit < 0 || control.GameLimit < 0 || control.MaxTimeouts < 0 {
+		return errors.New("the limits of the time control cannot be negative")
+	}
+	g.TimeControl = control
+	return nil
+}
+
+func (g *Game) now() time.Time {
+	if g.clock == nil {
+		return time.Now()
+	}
+	return g.clock()
+}
+
+// starts the clock of the turn in progress when the turn changed,
+// the time of the turn that ended goes to the game clock of its player
+func (g *Game) trackTurn() {
+	if g.clocks.turn == g.CurrentTurn {
+		return
+	}
+	now := g.now()
+	if g.clocks.turn != nil {
+		g.clocks.spent[g.clocks.turn.PlayerIndex] += now.Sub(g.clocks.startedAt)
+	}
+	g.clocks.turn = g.CurrentTurn
+	g.clocks.startedAt = now
+}
+
+// returns the time the player has left on the turn and game clocks, the turn clock
+// only runs during the turns of the player and clocks without limit report 0
+func (g *Game) RemainingTime(playerIndex int) (turn, game time.Duration) {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+	return g.remainingTime(playerIndex)
+}
+
+func (g *Game) remainingTime(playerIndex int) (turn, game time.Duration) {
+	var onTurn time.Duration
+	if g.State == GameInProgress && g.clocks.turn != nil && g.clocks.turn.PlayerIndex == playerIndex {
+		onTurn = g.now().Sub(g.clocks.startedAt)
+	}
+	if g.TimeControl.TurnLimit > 0 {
+		turn = max(g.TimeControl.TurnLimit-onTurn, 0)
+	}
+	if g.TimeControl.GameLimit > 0 {
+		game = max(g.TimeControl.GameLimit-g.clocks.spent[playerIndex]-onTurn, 0)
+	}
+	return turn, game
+}
+
+// times out the player in turn once one of its clocks runs out, it is meant to be called
+// periodically as Engine.RunClocks does
+func (g *Game) CheckClocks() error {
+	return g.play(g.timeoutEvent)
+}
+
+// returns nil while the player in turn has time left
+func (g *Game) timeoutEvent() (*Event, error) {
+	if g.State != GameInProgress {
+		return nil, nil
+	}
+	playerIndex := g.CurrentTurn.PlayerIndex
+	turn, game := g.remainingTime(playerIndex)
+	outOfGame := g.TimeControl.GameLimit > 0 && game == 0
+	outOfTurn := g.TimeControl.TurnLimit > 0 && turn == 0
+	if !outOfGame && !outOfTurn {
+		return nil, nil
+	}
+	return NewEvent(&TurnTimedOutPayload{PlayerIndex: playerIndex, GameClock: outOfGame})
+}
@@ -0,0 +1,141 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// returns a started game with the time control whose clock only moves when the test moves it
+func newGameWithClock(t *testing.T, control TimeControl) (*Game, *time.Time) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	game, _ := NewGame([2]*Deck{newDeckOfRealCards("PlayerA"), newDeckOfRealCards("PlayerB")})
+	game.SetClock(func() time.Time { return now })
+	assert.NoError(t, game.SetTimeControl(control))
+	assert.NoError(t, game.Start())
+	return game, &now
+}
+
+// plays the whole turn of the player right away
+func passTurn(t *testing.T, game *Game, playerIndex int) {
+	for range 3 {
+		assert.NoError(t, game.Apply(&NextPhaseAction{PlayerIndex: playerIndex}))
+	}
+	assert.NoError(t, game.Apply(&NextTurnAction{PlayerIndex: playerIndex}))
+}
+
+func TestTurnClockPassesTheTurn(t *testing.T) {
+	game, now := newGameWithClock(t, TimeControl{TurnLimit: 30 * time.Second, MaxTimeouts: 3})
+
+	*now = now.Add(29 * time.Second)
+	assert.NoError(t, game.CheckClocks())
+	assert.Equal(t, PLAYER_A, game.CurrentTurn.PlayerIndex)
+	turn, _ := game.RemainingTime(PLAYER_A)
+	assert.Equal(t, time.Second, turn)
+
+	*now = now.Add(time.Second)
+	assert.NoError(t, game.CheckClocks())
+	assert.Equal(t, PLAYER_B, game.CurrentTurn.PlayerIndex)
+	assert.Equal(t, DrawCardsPhase, game.CurrentTurn.Phase)
+	assert.Len(t, game.Decks[PLAYER_B].HandCards, MaxHandSize)
+	assert.Equal(t, [2]int{1, 0}, game.Timeouts)
+	assert.Equal(t, GameInProgress, game.State)
+
+	// the clock of the new turn starts full
+	turn, _ = game.RemainingTime(PLAYER_B)
+	assert.Equal(t, 30*time.Second, turn)
+}
+
+func TestRepeatedTimeoutsForfeitTheDuel(t *testing.T) {
+	game, now := newGameWithClock(t, TimeControl{TurnLimit: 10 * time.Second, MaxTimeouts: 2})
+
+	*now = now.Add(10 * time.Second)
+	assert.NoError(t, game.CheckClocks())
+	passTurn(t, game, PLAYER_B)
+	*now = now.Add(10 * time.Second)
+	assert.NoError(t, game.CheckClocks())
+
+	assert.Equal(t, GameFinished, game.State)
+	assert.Equal(t, PLAYER_B, game.WinnerIndex)
+	assert.Equal(t, WinByForfeit, game.WinReason)
+	assert.Equal(t, 20*time.Second, game.DuelDuration)
+
+	// the timeouts are events so the replay ends the same way
+	replayed, err := Replay(game.EventLog())
+	assert.NoError(t, err)
+	assert.Equal(t, WinByForfeit, replayed.WinReason)
+	assert.Equal(t, [2]int{2, 0}, replayed.Timeouts)
+}
+
+func TestGameClockRunsOut(t *testing.T) {
+	game, now := newGameWithClock(t, TimeControl{GameLimit: time.Minute})
+
+	*now = now.Add(40 * time.Second)
+	passTurn(t, game, PLAYER_A)
+	*now = now.Add(5 * time.Second)
+	passTurn(t, game, PLAYER_B)
+
+	_, left := game.RemainingTime(PLAYER_A)
+	assert.Equal(t, 20*time.Second, left)
+	_, left = game.RemainingTime(PLAYER_B)
+	assert.Equal(t, 55*time.Second, left)
+
+	*now = now.Add(20 * time.Second)
+	assert.NoError(t, game.CheckClocks())
+	assert.Equal(t, GameFinished, game.State)
+	assert.Equal(t, PLAYER_B, game.WinnerIndex)
+	assert.Equal(t, WinByTimeout, game.WinReason)
+}
+
+func TestClocksOfRestoredAndReplayedGames(t *testing.T) {
+	game, now := newGameWithClock(t, TimeControl{GameLimit: time.Minute})
+	*now = now.Add(40 * time.Second)
+	passTurn(t, game, PLAYER_A)
+	*now = now.Add(5 * time.Second)
+	passTurn(t, game, PLAYER_B)
+	*now = now.Add(10 * time.Second)
+
+	snapshot, err := game.Snapshot()
+	assert.NoError(t, err)
+	restored, err := RestoreGame(snapshot)
+	assert.NoError(t, err)
+	replayed, err := Replay(game.EventLog())
+	assert.NoError(t, err)
+
+	// the time used before the snapshot and the running turn are not given back
+	for _, current := range []*Game{game, restored, replayed} {
+		current.SetClock(func() time.Time { return *now })
+		_, left := current.RemainingTime(PLAYER_A)
+		assert.Equal(t, 10*time.Second, left)
+		_, left = current.RemainingTime(PLAYER_B)
+		assert.Equal(t, 55*time.Second, left)
+	}
+}
+
+func TestGameWithoutTimeControl(t *testing.T) {
+	game, now := newGameWithClock(t, TimeControl{})
+
+	*now = now.Add(24 * time.Hour)
+	assert.NoError(t, game.CheckClocks())
+	assert.Equal(t, GameInProgress, game.State)
+	assert.Equal(t, PLAYER_A, game.CurrentTurn.PlayerIndex)
+}
+
+func TestInvalidTimeControl(t *testing.T) {
+	game, _ := NewGame([2]*Deck{newDeckOfRealCards("PlayerA"), newDeckOfRealCards("PlayerB")})
+	err := game.SetTimeControl(TimeControl{TurnLimit: -time.Second})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot be negative")
+
+	game.Start()
+	err = game.SetTimeControl(TimeControl{TurnLimit: time.Second})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the time control can only be set in the READY_TO_START state")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
@@ -20,6 +20,9 @@ const (
 	DeckTypeGeneric   DeckType = "GENERIC"
 )
 
+// cards a player can hold in the hand
+const MaxHandSize = 5
+
 var validDeckTypes = []DeckType{DeckTypeFemale, DeckTypeMountain, DeckTypeYami, DeckTypeForest, DeckTypeAqua, DeckTypeWarrior, DeckTypeWasteland, DeckTypeGeneric}
 
 // Deck represents a collection of cards that a player can use in a game
@@ -67,6 +70,27 @@ func (d *Deck) MoveCardsFromRemainingToHand(count int) error {
 	return nil
 }
 
+// returns the first card in the hand created from the template
+func (d *Deck) GetHandCard(templateID int) (*CardInstance, error) {
+	index := slices.IndexFunc(d.HandCards, func(card *CardInstance) bool {
+		return card.Template.ID == templateID
+	})
+	if index < 0 {
+		return nil, fmt.Errorf("card %d is not in the hand", templateID)
+	}
+	return d.HandCards[index], nil
+}
+
+// sends a card from the board or the hand to the graveyard
+func (d *Deck) DestroyCard(card *CardInstance) {
+	isTheCard := func(other *CardInstance) bool {
+		return other == card
+	}
+	d.ActiveCardsOnBoard = slices.DeleteFunc(d.ActiveCardsOnBoard, isTheCard)
+	d.HandCards = slices.DeleteFunc(d.HandCards, isTheCard)
+	d.DestroyedCards = append(d.DestroyedCards, card)
+}
+
 func (d *Deck) SetDeckType(deckType DeckType) error {
 	// verify if deckType is in the list of validDeckTypes
 	if slices.Contains(validDeckTypes, deckType) {
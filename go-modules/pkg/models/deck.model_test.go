@@ -6,6 +6,17 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+// returns a deck with the cards 2 to 41, the real cards must be loaded first
+func newDeckOfRealCards(username string) *Deck {
+	cards := [40]*CardInstance{}
+	for index := range cards {
+		cards[index], _ = NewCardInstance(index + 2)
+	}
+	player, _ := NewPlayer(username)
+	deck, _ := NewDeck(player, cards)
+	return deck
+}
+
 func TestNewDeck(t *testing.T) {
 	player, err := NewPlayer("TestPlayer")
 	assert.NoError(t, err)
@@ -1,24 +1,20 @@
 package models
 
-import (
-	"errors"
-	"fmt"
-)
+import "fmt"
 
-func EventDeckShuffledFn(event *Event) error {
-	if event.Type != EventDeckShuffled {
-		return fmt.Errorf("invalid event type %s: expected %s", event.Type, EventDeckShuffled)
-	}
-	if event.Data["status"] != SOEProcessing {
-		return fmt.Errorf("invalid event status %s: expected %s", event.Data["status"], SOEProcessing)
-	}
+type DeckShuffledPayload struct {
+	PlayerIndex int
+}
 
-	// gathering requirements
-	deck, deckExists := event.Data["deck"].(*Deck)
-	if !deckExists {
-		return errors.New("deck missing")
-	}
+func (*DeckShuffledPayload) EventType() EventType { return EventDeckShuffled }
+func (p *DeckShuffledPayload) player() int        { return p.PlayerIndex }
 
-	fmt.Println("Shuffling cards on deck...", deck)
+// shuffles with the random generator of the game so the same seed always gives the same order
+func EventDeckShuffledFn(game *Game, payload *DeckShuffledPayload) error {
+	deck := game.Decks[payload.PlayerIndex]
+	fmt.Printf("Shuffling the deck of %s...\n", deck.Player.Username)
+	game.rng.Shuffle(len(deck.RemainingCards), func(i, j int) {
+		deck.RemainingCards[i], deck.RemainingCards[j] = deck.RemainingCards[j], deck.RemainingCards[i]
+	})
 	return nil
 }
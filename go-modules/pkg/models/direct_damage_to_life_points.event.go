@@ -0,0 +1,21 @@
+package models
+
+import "fmt"
+
+type DirectDamageToLifePointsPayload struct {
+	PlayerIndex int
+	Damage      int
+}
+
+func (*DirectDamageToLifePointsPayload) EventType() EventType { return EventDirectDamageToLifePoints }
+func (p *DirectDamageToLifePointsPayload) player() int        { return p.PlayerIndex }
+
+func EventDirectDamageToLifePointsFn(game *Game, payload *DirectDamageToLifePointsPayload) error {
+	player := game.Decks[payload.PlayerIndex].Player
+	fmt.Printf("%s loses %d life points...\n", player.Username, payload.Damage)
+	player.LifePoints = max(player.LifePoints-payload.Damage, 0)
+	if player.LifePoints > 0 || game.State != GameInProgress {
+		return nil
+	}
+	return game.declareWinner((payload.PlayerIndex+1)%2, WinByLifePoints)
+}
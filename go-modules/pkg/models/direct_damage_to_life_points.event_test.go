@@ -0,0 +1,23 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventDirectDamageToLifePointsFnWithSuccess(t *testing.T) {
+	game := newGameInActionPhase()
+	err := EventDirectDamageToLifePointsFn(game, &DirectDamageToLifePointsPayload{PlayerIndex: PLAYER_B, Damage: 3000})
+	assert.NoError(t, err)
+	assert.Equal(t, 5000, game.Decks[PLAYER_B].Player.LifePoints)
+	assert.Equal(t, GameInProgress, game.State)
+
+	// life points never go below zero and the opponent wins
+	err = EventDirectDamageToLifePointsFn(game, &DirectDamageToLifePointsPayload{PlayerIndex: PLAYER_B, Damage: 9000})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, game.Decks[PLAYER_B].Player.LifePoints)
+	assert.Equal(t, GameFinished, game.State)
+	assert.Equal(t, 1, game.Decks[PLAYER_A].Player.WinCount)
+	assert.Equal(t, WinByLifePoints, game.WinReason)
+}
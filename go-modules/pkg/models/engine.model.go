@@ -1,18 +1,25 @@
 package models
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
 	"time"
 )
 
+// stores the finished games removed from the engine
+type GameArchive interface {
+	ArchiveGame(game *Game) error
+}
+
 // Only games with State = GameInProgress can live inside activeGames
 type Engine struct {
 	activeGames         map[string]*Game
 	mutex               sync.RWMutex
 	startTime           time.Time
 	totalGamesProcessed int
+	archive             GameArchive
 }
 
 func NewEngine() *Engine {
@@ -22,6 +29,13 @@ func NewEngine() *Engine {
 	}
 }
 
+// finished games are archived when they are removed, a game that cannot be archived stays in the engine
+func (e *Engine) SetArchive(archive GameArchive) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.archive = archive
+}
+
 func (e *Engine) GetEngineUptime() time.Duration {
 	e.mutex.RLock()
 	defer e.mutex.RUnlock()
@@ -38,8 +52,8 @@ func (e *Engine) GetTotalGamesProcessed() int {
 func (e *Engine) AddGame(game *Game) error {
 	e.mutex.Lock()
 	defer e.mutex.Unlock()
-	if game.State != GameInProgress {
-		return fmt.Errorf("only games with State = %s can be added to the engine, got %s", GameInProgress, game.State)
+	if state := game.GetState(); state != GameInProgress {
+		return fmt.Errorf("only games with State = %s can be added to the engine, got %s", GameInProgress, state)
 	}
 	e.activeGames[game.ID] = game
 	return nil
@@ -63,18 +77,65 @@ func (e *Engine) GetActiveGamesCount() int {
 	return len(e.activeGames)
 }
 
-// game should be finished already to be removed
+// game should be finished already to be removed, it is taken out of the engine while it is
+// archived so a slow archive does not block the other games
 func (e *Engine) RemoveGame(gameID string) error {
 	e.mutex.Lock()
-	defer e.mutex.Unlock()
 	game, exists := e.activeGames[gameID]
 	if !exists {
+		e.mutex.Unlock()
 		return errors.New("cannot remove game because not found")
 	}
-	if game.State != GameFinished {
-		return fmt.Errorf("only games with State = %s can be removed from the engine, got %s", GameFinished, game.State)
+	if state := game.GetState(); state != GameFinished {
+		e.mutex.Unlock()
+		return fmt.Errorf("only games with State = %s can be removed from the engine, got %s", GameFinished, state)
 	}
 	delete(e.activeGames, gameID)
+	archive := e.archive
+	e.mutex.Unlock()
+
+	if archive != nil {
+		if err := archive.ArchiveGame(game); err != nil {
+			e.mutex.Lock()
+			defer e.mutex.Unlock()
+			if _, taken := e.activeGames[gameID]; !taken {
+				e.activeGames[gameID] = game
+			}
+			return fmt.Errorf("cannot remove game because it could not be archived: %w", err)
+		}
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
 	e.totalGamesProcessed++
 	return nil
 }
+
+// checks the clocks of the active games on every tick until the context is done
+func (e *Engine) RunClocks(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		e.checkClocks()
+	}
+}
+
+func (e *Engine) checkClocks() {
+	e.mutex.RLock()
+	games := make([]*Game, 0, len(e.activeGames))
+	for _, game := range e.activeGames {
+		games = append(games, game)
+	}
+	e.mutex.RUnlock()
+
+	for _, game := range games {
+		if err := game.CheckClocks(); err != nil {
+			fmt.Printf("cannot check the clocks of game %s: %v\n", game.ID, err)
+		}
+	}
+}
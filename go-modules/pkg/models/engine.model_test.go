@@ -1,6 +1,7 @@
 package models
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -92,6 +93,79 @@ func TestRemoveGame(t *testing.T) {
 	assert.Equal(t, 1, engine.GetTotalGamesProcessed(), "should be 1 game processed")
 }
 
+// keeps the archived games in memory, or fails when err is set
+type fakeArchive struct {
+	games []*Game
+	err   error
+}
+
+func (a *fakeArchive) ArchiveGame(game *Game) error {
+	if a.err != nil {
+		return a.err
+	}
+	a.games = append(a.games, game)
+	return nil
+}
+
+func TestRemoveGameArchivesIt(t *testing.T) {
+	engine := NewEngine()
+	archive := &fakeArchive{err: errors.New("disk is full")}
+	engine.SetArchive(archive)
+
+	playerA, _ := NewPlayer("PlayerA")
+	playerB, _ := NewPlayer("PlayerB")
+	deck1, _ := NewDeck(playerA, [40]*CardInstance{})
+	deck2, _ := NewDeck(playerB, [40]*CardInstance{})
+	game, _ := NewGame([2]*Deck{deck1, deck2})
+	game.Start()
+	engine.AddGame(game)
+	game.Finish()
+
+	// the game is kept until it is archived
+	err := engine.RemoveGame(game.ID)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot remove game because it could not be archived: disk is full")
+	assert.Equal(t, 1, engine.GetActiveGamesCount())
+
+	archive.err = nil
+	assert.NoError(t, engine.RemoveGame(game.ID))
+	assert.Equal(t, []*Game{game}, archive.games)
+	assert.Equal(t, 0, engine.GetActiveGamesCount())
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+// reads the engine while the game is archived
+type engineReadingArchive struct {
+	engine      *Engine
+	activeGames int
+}
+
+func (a *engineReadingArchive) ArchiveGame(game *Game) error {
+	a.activeGames = a.engine.GetActiveGamesCount()
+	return nil
+}
+
+func TestRemoveGameDoesNotLockTheEngineWhileArchiving(t *testing.T) {
+	engine := NewEngine()
+	archive := &engineReadingArchive{engine: engine, activeGames: -1}
+	engine.SetArchive(archive)
+
+	playerA, _ := NewPlayer("PlayerA")
+	playerB, _ := NewPlayer("PlayerB")
+	deck1, _ := NewDeck(playerA, [40]*CardInstance{})
+	deck2, _ := NewDeck(playerB, [40]*CardInstance{})
+	game, _ := NewGame([2]*Deck{deck1, deck2})
+	game.Start()
+	engine.AddGame(game)
+	game.Finish()
+
+	assert.NoError(t, engine.RemoveGame(game.ID))
+	assert.Equal(t, 0, archive.activeGames, "the game is out of the engine while it is archived")
+	assert.Equal(t, 1, engine.GetTotalGamesProcessed())
+}
+
 func TestGetEngineUptime(t *testing.T) {
 	engine := NewEngine()
 
@@ -100,3 +174,16 @@ func TestGetEngineUptime(t *testing.T) {
 
 	assert.GreaterOrEqual(t, engine.GetEngineUptime(), time.Duration(1*time.Microsecond), "at least 1 microsecond should have elapsed since the engine started")
 }
+
+func TestEngineChecksTheClocks(t *testing.T) {
+	engine := NewEngine()
+	game, now := newGameWithClock(t, TimeControl{TurnLimit: time.Minute})
+	assert.NoError(t, engine.AddGame(game))
+
+	engine.checkClocks()
+	assert.Equal(t, PLAYER_A, game.CurrentTurn.PlayerIndex)
+
+	*now = now.Add(time.Minute)
+	engine.checkClocks()
+	assert.Equal(t, PLAYER_B, game.CurrentTurn.PlayerIndex)
+}
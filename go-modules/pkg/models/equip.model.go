@@ -0,0 +1,76 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+)
+
+// the target position of the equip events raised inside a fusion chain
+const InFusionChain = -1
+
+// checks the target monster against the equip rules of the equip card
+func CanEquip(equip, target *CardInstance) error {
+	if equip.Template.Type != TypeEquip || equip.Template.EquipRules == nil {
+		return fmt.Errorf("card %q is not an equip card", equip.Template.Name)
+	}
+	if !slices.Contains(equip.Template.EquipRules.ValidTargetIDs, target.Template.ID) {
+		return fmt.Errorf("card %q cannot be equipped to %q", equip.Template.Name, target.Template.Name)
+	}
+	return nil
+}
+
+// returns a copy of the target monster powered up by the equip card and the event
+// that records it, fusion chains use it on the monster being fused
+func Equip(equip, target *CardInstance) (*CardInstance, *Event, error) {
+	if err := CanEquip(equip, target); err != nil {
+		return nil, nil, err
+	}
+	event, err := NewEvent(&EquipCardAttachedPayload{
+		EquipID:  equip.Template.ID,
+		TargetID: target.Template.ID,
+		Position: InFusionChain,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	equipped := *target
+	equipped.CurrentAttack += equip.Template.EquipRules.Bonus
+	equipped.CurrentDefense += equip.Template.EquipRules.Bonus
+	return &equipped, event, nil
+}
+
+// attaches an equip card of the current player to one of its monsters on the board
+func (g *Game) EquipCard(equip *CardInstance, position int) error {
+	return g.play(func() (*Event, error) {
+		return g.equipEvent(equip, position)
+	})
+}
+
+func (g *Game) equipEvent(equip *CardInstance, position int) (*Event, error) {
+	if err := g.checkCardPlay(g.CurrentTurn.PlayerIndex, "equip a card"); err != nil {
+		return nil, err
+	}
+
+	playerIndex := g.CurrentTurn.PlayerIndex
+	if !slices.Contains(g.Decks[playerIndex].HandCards, equip) {
+		return nil, fmt.Errorf("card %q is not in the hand", equip.Template.Name)
+	}
+	target, err := g.Board.GetMonsterAtIndexPosition(playerIndex, position)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return nil, fmt.Errorf("there is no monster to equip at position %d", position)
+	}
+	if err := CanEquip(equip, target.Card); err != nil {
+		return nil, err
+	}
+
+	return NewEvent(&EquipCardAttachedPayload{
+		PlayerIndex: playerIndex,
+		EquipID:     equip.Template.ID,
+		TargetID:    target.Card.Template.ID,
+		Position:    position,
+	})
+}
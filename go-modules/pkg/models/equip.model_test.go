@@ -0,0 +1,131 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEquipCardsLoadedFromYAML(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+
+	equips := 0
+	for id := 1; id <= 722; id++ {
+		template := GetCardRegistry().GetCard(id)
+		if template == nil || template.Type != TypeEquip {
+			continue
+		}
+		equips++
+		assert.NotNil(t, template.EquipRules, "equip card %d has no equip rules", id)
+		assert.NotEmpty(t, template.EquipRules.ValidTargetIDs)
+		assert.Greater(t, template.EquipRules.Bonus, 0)
+	}
+	assert.Equal(t, 34, equips)
+	assert.Equal(t, 1000, GetCardRegistry().GetCard(657).EquipRules.Bonus) // Megamorph
+}
+
+func TestEquip(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	hand := newHand(t, 301, 15, 4) // Legendary Sword, Flame Swordsman, Baby Dragon
+	legendarySword, flameSwordsman, babyDragon := hand[0], hand[1], hand[2]
+
+	err := CanEquip(legendarySword, babyDragon)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot be equipped to")
+
+	err = CanEquip(flameSwordsman, babyDragon)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is not an equip card")
+
+	_, _, err = Equip(legendarySword, babyDragon)
+	assert.Error(t, err)
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+
+	equipped, event, err := Equip(legendarySword, flameSwordsman)
+	assert.NoError(t, err)
+	assert.Equal(t, EventEquipCardAttached, event.Type)
+	assert.Equal(t, InFusionChain, event.Payload.(*EquipCardAttachedPayload).Position)
+	assert.Equal(t, 1800, flameSwordsman.CurrentAttack, "the original monster is not modified")
+	assert.Equal(t, 2300, equipped.CurrentAttack)
+	assert.Equal(t, 2100, equipped.CurrentDefense)
+
+	new(Game).dispatch(event)
+	assert.Equal(t, SOECompleted, event.Status)
+}
+
+func TestGameEquipCard(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	game := newGameInActionPhase()
+	hand := newHand(t, 301, 15, 4) // Legendary Sword, Flame Swordsman, Baby Dragon
+	legendarySword, flameSwordsman, babyDragon := hand[0], hand[1], hand[2]
+	game.Decks[PLAYER_A].HandCards = []*CardInstance{legendarySword}
+	placeMonster(game, PLAYER_A, 0, &CardState{Card: flameSwordsman, FaceUp: true})
+	placeMonster(game, PLAYER_A, 1, &CardState{Card: babyDragon, FaceUp: true})
+
+	err := game.EquipCard(legendarySword, 0)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot equip a card in the current turn phase")
+
+	game.CurrentTurn.Phase = PlaceCardsPhase
+	err = game.EquipCard(legendarySword, 2)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "there is no monster to equip at position")
+
+	err = game.EquipCard(legendarySword, 1)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot be equipped to")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+
+	err = game.EquipCard(legendarySword, 0)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2300, flameSwordsman.CurrentAttack)
+	assert.Equal(t, 2100, flameSwordsman.CurrentDefense)
+	assert.Empty(t, game.Decks[PLAYER_A].HandCards)
+	assert.Equal(t, []*CardInstance{legendarySword}, game.Decks[PLAYER_A].DestroyedCards)
+}
+
+func TestFuseWithEquipCards(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	LoadRealFusionsFromYAML()
+
+	t.Run("should attach the equip card no matter the order", func(t *testing.T) {
+		hand := newHand(t, 301, 15) // Legendary Sword, Flame Swordsman
+		result, events, err := Fuse(hand, []int{0, 1})
+		assert.NoError(t, err)
+		assert.Equal(t, 15, result.Template.ID)
+		assert.Equal(t, 2300, result.CurrentAttack)
+		assert.Equal(t, 1, len(events))
+		assert.Equal(t, EventEquipCardAttached, events[0].Type)
+
+		result, _, err = Fuse(hand, []int{1, 0})
+		assert.NoError(t, err)
+		assert.Equal(t, 2300, result.CurrentAttack)
+	})
+
+	t.Run("should fail when the monster is not a valid target", func(t *testing.T) {
+		hand := newHand(t, 301, 4) // Legendary Sword, Baby Dragon
+		result, events, err := Fuse(hand, []int{0, 1})
+		assert.NoError(t, err)
+		assert.Equal(t, hand[1], result)
+		assert.Equal(t, EventCardFusionFailed, events[0].Type)
+	})
+
+	t.Run("should keep fusing after equipping", func(t *testing.T) {
+		hand := newHand(t, 4, 315, 45) // Baby Dragon, Dragon Treasure, Oscillo Hero #2
+		result, events, err := Fuse(hand, []int{0, 1, 2})
+		assert.NoError(t, err)
+		assert.Equal(t, 425, result.Template.ID) // Thunder Dragon
+		assert.Equal(t, 2, len(events))
+		assert.Equal(t, EventEquipCardAttached, events[0].Type)
+		assert.Equal(t, EventCardFused, events[1].Type)
+	})
+}
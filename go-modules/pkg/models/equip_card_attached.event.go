@@ -0,0 +1,68 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+type EquipCardAttachedPayload struct {
+	PlayerIndex int
+	EquipID     int // equip card in the hand of the player
+	TargetID    int
+	Position    int // position of the target monster on the board or InFusionChain
+}
+
+func (*EquipCardAttachedPayload) EventType() EventType { return EventEquipCardAttached }
+func (p *EquipCardAttachedPayload) player() int        { return p.PlayerIndex }
+
+func EventEquipCardAttachedFn(game *Game, payload *EquipCardAttachedPayload) error {
+	equipTemplate := GetCardRegistry().GetCard(payload.EquipID)
+	if equipTemplate == nil {
+		return errors.New("equip card missing")
+	}
+	targetTemplate := GetCardRegistry().GetCard(payload.TargetID)
+	if targetTemplate == nil {
+		return errors.New("target monster missing")
+	}
+
+	// the fusion already powered up the monster it returned
+	if payload.Position == InFusionChain {
+		fmt.Printf("Equipping %s to %s in the fusion chain...\n", equipTemplate.Name, targetTemplate.Name)
+		return nil
+	}
+
+	if err := game.checkCardPlay(payload.PlayerIndex, "equip a card"); err != nil {
+		return err
+	}
+	deck := game.Decks[payload.PlayerIndex]
+	equip, err := deck.GetHandCard(payload.EquipID)
+	if err != nil {
+		return err
+	}
+	target, err := game.Board.GetMonsterAtIndexPosition(payload.PlayerIndex, payload.Position)
+	if err != nil {
+		return err
+	}
+	if target == nil || target.Card.Template.ID != payload.TargetID {
+		return errors.New("target monster missing")
+	}
+	if err := CanEquip(equip, target.Card); err != nil {
+		return err
+	}
+
+	fmt.Printf("Equipping %s to %s...\n", equipTemplate.Name, targetTemplate.Name)
+	target.Card.CurrentAttack += equipTemplate.EquipRules.Bonus
+	target.Card.CurrentDefense += equipTemplate.EquipRules.Bonus
+	deck.DestroyCard(equip)
+	game.CurrentTurn.CardPlaced = true
+	return nil
+}
+
+// a monster equipped in a fusion chain is placed face-down, so the opponent does not learn
+// the cards of the chain
+func (p *EquipCardAttachedPayload) redactFor(playerIndex int) Payload {
+	if playerIndex == p.PlayerIndex || p.Position != InFusionChain {
+		return p
+	}
+	return &EquipCardAttachedPayload{PlayerIndex: p.PlayerIndex, Position: p.Position}
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvalidEventEquipCardAttachedFn(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	game := newGameInActionPhase()
+	game.CurrentTurn.Phase = PlaceCardsPhase
+
+	err := EventEquipCardAttachedFn(game, &EquipCardAttachedPayload{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "equip card missing")
+
+	err = EventEquipCardAttachedFn(game, &EquipCardAttachedPayload{EquipID: 301})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "target monster missing")
+
+	// Legendary Sword, Flame Swordsman
+	payload := &EquipCardAttachedPayload{PlayerIndex: PLAYER_A, EquipID: 301, TargetID: 15, Position: 0}
+	err = EventEquipCardAttachedFn(game, payload)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is not in the hand")
+
+	game.Decks[PLAYER_A].HandCards = newHand(t, 301)
+	err = EventEquipCardAttachedFn(game, payload)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "target monster missing")
+
+	// the equip rules are checked again before applying the bonus
+	babyDragon := &CardState{Card: newHand(t, 4)[0]}
+	placeMonster(game, PLAYER_A, 0, babyDragon)
+	payload.TargetID = 4
+	err = EventEquipCardAttachedFn(game, payload)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot be equipped to")
+	assert.Equal(t, 1200, babyDragon.Card.CurrentAttack)
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
@@ -1,7 +1,10 @@
 package models
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
 	"time"
 )
 
@@ -12,6 +15,7 @@ const (
 	SOEEnqueued   StatusOfEvent = "ENQUEUED"
 	SOEProcessing StatusOfEvent = "IN_PROCESS"
 	SOECompleted  StatusOfEvent = "COMPLETED"
+	SOEFailed     StatusOfEvent = "FAILED"
 )
 
 type EventType string
@@ -40,48 +44,155 @@ const (
 	EventPlayerLoses                     EventType = "PLAYER_LOSES"
 	EventTurnPhaseChange                 EventType = "TURN_PHASE_CHANGE"
 	EventProhibitOpponentToAtack         EventType = "PROHIBIT_OPPONENT_TO_ATACK"
+	EventCardsDrawn                      EventType = "CARDS_DRAWN"
+	EventCardPlaced                      EventType = "CARD_PLACED"
+	EventTurnTimedOut                    EventType = "TURN_TIMED_OUT"
 )
 
-var validEventTypes = map[EventType]func(event *Event) error{
-	EventDeckShuffled: EventDeckShuffledFn,
-	// EventOneCardDroppedOrDestroyed:       true,
-	// EventBulkCardDestruction:             true,
-	// EventCardFusionFailed:                true,
-	// EventCardFused:                       true,
-	// EventMonsterBattle:                   true,
-	// EventSacrificeCardsForRitual:         true,
-	// EventOneCardStateAndPositionChanged:  true,
-	// EventBulkCardStateAndPositionChanged: true,
-	// EventGetOutOfCards:                   true,
-	// EventOneCardPointsUpdate:             true,
-	// EventBulkCardPointsUpdate:            true,
-	// EventDirectDamageToLifePoints:        true,
-	// EventPlayerLifePointsUpdate:          true,
-	// EventTrapActivated:                   true,
-	// EventChangeFieldLand:                 true,
-	// EventEquipCardAttached:               true,
-	// EventGuardianStarChange:              true,
-	// EventMagicCardActivated:              true,
-	// EventPlayerWins:                      true,
-	// EventPlayerLoses:                     true,
-	// EventTurnPhaseChange:                 true,
-	EventProhibitOpponentToAtack: EventProhibitOpponentToAtackFn,
+// every event type has its own payload struct, the payload tells the type of its event
+type Payload interface {
+	EventType() EventType
+}
+
+// payloads naming a player, Dispatch rejects them when the player does not exist
+type playerPayload interface {
+	player() int
+}
+
+// handlers receive the game the event belongs to, registered handlers are adapted by Dispatch
+var eventHandlers = map[EventType]func(game *Game, event *Event) error{}
+
+// creates an empty payload of the event type, used to decode stored events
+var payloadFactories = map[EventType]func() Payload{}
+
+// handlers are registered on init because some of them trigger other events
+func init() {
+	register(EventDeckShuffledFn)
+	// EventOneCardDroppedOrDestroyed
+	register(EventBulkCardDestructionFn)
+	register(EventCardFusionFailedFn)
+	register(EventCardFusedFn)
+	register(EventMonsterBattleFn)
+	register(EventSacrificeCardsForRitualFn)
+	register(EventOneCardStateAndPositionChangedFn)
+	register(EventBulkCardStateAndPositionChangedFn)
+	register(EventGetOutOfCardsFn)
+	// EventOneCardPointsUpdate
+	register(EventBulkCardPointsUpdateFn)
+	register(EventDirectDamageToLifePointsFn)
+	register(EventPlayerLifePointsUpdateFn)
+	register(EventTrapActivatedFn)
+	register(EventChangeFieldLandFn)
+	register(EventEquipCardAttachedFn)
+	register(EventGuardianStarChangeFn)
+	register(EventMagicCardActivatedFn)
+	register(EventPlayerWinsFn)
+	register(EventPlayerLosesFn)
+	register(EventTurnPhaseChangeFn)
+	register(EventProhibitOpponentToAtackFn)
+	register(EventCardsDrawnFn)
+	register(EventCardPlacedFn)
+	register(EventTurnTimedOutFn)
+}
+
+// the event type of a handler is taken from its payload type, so a handler
+// can never be registered under the event type of another payload
+func register[T Payload](handler func(game *Game, payload T) error) {
+	var payload T
+	eventHandlers[payload.EventType()] = func(game *Game, event *Event) error {
+		return Dispatch(game, event, handler)
+	}
+	payloadType := reflect.TypeOf(payload).Elem()
+	payloadFactories[payload.EventType()] = func() Payload {
+		return reflect.New(payloadType).Interface().(Payload)
+	}
 }
 
 type Event struct {
 	Type      EventType
 	Timestamp time.Time
-	Data      map[string]any // Flexible data storage
+	Status    StatusOfEvent
+	Payload   Payload
+	Err       error         // why the handler rejected the event when its status is SOEFailed
+	done      chan struct{} // closed once the event is processed, only for AddEventAndWait
+}
+
+// stored form of an event, the error only keeps its message
+type eventJSON struct {
+	Type      EventType
+	Timestamp time.Time
+	Status    StatusOfEvent
+	Payload   json.RawMessage
+	Err       string `json:",omitempty"`
+}
+
+func (e *Event) MarshalJSON() ([]byte, error) {
+	payload, err := json.Marshal(e.Payload)
+	if err != nil {
+		return nil, err
+	}
+	stored := eventJSON{Type: e.Type, Timestamp: e.Timestamp, Status: e.Status, Payload: payload}
+	if e.Err != nil {
+		stored.Err = e.Err.Error()
+	}
+	return json.Marshal(stored)
+}
+
+// the payload is decoded into the payload type registered for the event type
+func (e *Event) UnmarshalJSON(data []byte) error {
+	stored := eventJSON{}
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return err
+	}
+	newPayload, exists := payloadFactories[stored.Type]
+	if !exists {
+		return fmt.Errorf("invalid event type %q: no handler registered", stored.Type)
+	}
+	payload := newPayload()
+	if err := json.Unmarshal(stored.Payload, payload); err != nil {
+		return err
+	}
+
+	*e = Event{Type: stored.Type, Timestamp: stored.Timestamp, Status: stored.Status, Payload: payload}
+	if stored.Err != "" {
+		e.Err = errors.New(stored.Err)
+	}
+	return nil
+}
+
+// outcome of an event processed by the game
+type EventResult struct {
+	Event *Event
+	Err   error
+}
+
+func NewEvent(payload Payload) (*Event, error) {
+	eventType := payload.EventType()
+	if _, eventTypeExists := eventHandlers[eventType]; !eventTypeExists {
+		return nil, fmt.Errorf("invalid event type %q: no handler registered", eventType)
+	}
+	return &Event{
+		Type:      eventType,
+		Timestamp: time.Now(),
+		Status:    SOEPristine,
+		Payload:   payload,
+	}, nil
 }
 
-func NewEvent(eventType EventType, data map[string]any) (*Event, error) {
-	data["status"] = SOEPristine
-	if _, eventTypeExists := validEventTypes[eventType]; eventTypeExists {
-		return &Event{
-			Type:      eventType,
-			Timestamp: time.Now(),
-			Data:      data,
-		}, nil
+// runs a typed handler with the payload of the event, passing a handler whose
+// payload type does not implement Payload fails at compile time
+func Dispatch[T Payload](game *Game, event *Event, handler func(game *Game, payload T) error) error {
+	if event.Status != SOEProcessing {
+		return fmt.Errorf("invalid event status %s: expected %s", event.Status, SOEProcessing)
+	}
+	payload, payloadMatches := event.Payload.(T)
+	if !payloadMatches || payload.EventType() != event.Type {
+		return fmt.Errorf("invalid payload %T for event type %s", event.Payload, event.Type)
+	}
+	if named, namesPlayer := event.Payload.(playerPayload); namesPlayer {
+		if err := checkPlayerIndex(named.player()); err != nil {
+			return err
+		}
 	}
-	return nil, fmt.Errorf("invalid event type %q: expected one of [%v]", eventType, validEventTypes)
+	return handler(game, payload)
 }
@@ -0,0 +1,118 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDispatch(t *testing.T) {
+	game := newGameInActionPhase()
+	event, err := NewEvent(&PlayerLifePointsUpdatePayload{PlayerIndex: PLAYER_A, Points: 500})
+	assert.NoError(t, err)
+	assert.Equal(t, EventPlayerLifePointsUpdate, event.Type)
+	assert.Equal(t, SOEPristine, event.Status)
+
+	// hardcode an invalid status
+	err = Dispatch(game, event, EventPlayerLifePointsUpdateFn)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid event status")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+
+	// hardcode a payload of another event type
+	event.Status = SOEProcessing
+	event.Payload = &PlayerWinsPayload{PlayerIndex: PLAYER_A}
+	err = Dispatch(game, event, EventPlayerLifePointsUpdateFn)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid payload")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+
+	event.Payload = &PlayerLifePointsUpdatePayload{PlayerIndex: PLAYER_A, Points: 500}
+	err = Dispatch(game, event, EventPlayerLifePointsUpdateFn)
+	assert.NoError(t, err)
+	assert.Equal(t, 8500, game.Decks[PLAYER_A].Player.LifePoints)
+}
+
+func TestDispatchInvalidPlayerIndex(t *testing.T) {
+	game := newGameInActionPhase()
+	payloads := []Payload{
+		&DirectDamageToLifePointsPayload{PlayerIndex: 7, Damage: 500},
+		&PlayerLifePointsUpdatePayload{PlayerIndex: -1, Points: 500},
+		&ProhibitOpponentToAtackPayload{OpponentIndex: 2, Turns: 1},
+		&DeckShuffledPayload{PlayerIndex: 7},
+		&CardsDrawnPayload{PlayerIndex: 7, Count: 1},
+		&PlayerWinsPayload{PlayerIndex: 7},
+	}
+	var err error
+	for _, payload := range payloads {
+		event, _ := NewEvent(payload)
+		_, err = game.AddEventAndWait(context.Background(), event)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid player index")
+		assert.Equal(t, SOEFailed, event.Status)
+	}
+
+	// the game goes on after the rejected events
+	event, _ := NewEvent(&PlayerLifePointsUpdatePayload{PlayerIndex: PLAYER_A, Points: 500})
+	_, err = game.AddEventAndWait(context.Background(), event)
+	assert.NoError(t, err)
+	assert.Equal(t, 8500, game.Decks[PLAYER_A].Player.LifePoints)
+
+	// every payload naming a player gets its index checked
+	for eventType, newPayload := range payloadFactories {
+		payloadType := reflect.TypeOf(newPayload()).Elem()
+		_, hasPlayer := payloadType.FieldByName("PlayerIndex")
+		_, hasOpponent := payloadType.FieldByName("OpponentIndex")
+		if hasPlayer || hasOpponent {
+			assert.Implements(t, (*playerPayload)(nil), newPayload(), "the player of %s is not checked", eventType)
+		}
+	}
+}
+
+func TestDispatchFailedEvent(t *testing.T) {
+	game := newGameInActionPhase()
+	event, _ := NewEvent(&MonsterBattlePayload{PlayerIndex: PLAYER_A, AttackerPosition: 0, DefenderPosition: 0})
+
+	err := game.dispatch(event)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "attacker monster missing")
+	assert.Equal(t, SOEFailed, event.Status)
+	assert.Equal(t, err, event.Err)
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+func TestEventJSON(t *testing.T) {
+	event, _ := NewEvent(&BulkCardPointsUpdatePayload{
+		Positions: []BoardPosition{{PlayerIndex: PLAYER_B, Position: 3}},
+		Points:    -200,
+	})
+	event.Status = SOEFailed
+	event.Err = errors.New("something went wrong")
+
+	data, err := json.Marshal(event)
+	assert.NoError(t, err)
+	decoded := &Event{}
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, event.Type, decoded.Type)
+	assert.True(t, event.Timestamp.Equal(decoded.Timestamp))
+	assert.Equal(t, SOEFailed, decoded.Status)
+	assert.Equal(t, event.Payload, decoded.Payload)
+	assert.EqualError(t, decoded.Err, "something went wrong")
+
+	err = json.Unmarshal([]byte(`{"Type": "UNKNOWN", "Payload": {}}`), decoded)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no handler registered")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
@@ -0,0 +1,94 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+)
+
+// one event processed by the game, sequences start at 1
+type LogEntry struct {
+	Sequence    int
+	Event       *Event
+	Chained     []*Event  // events the handlers triggered in order, replaying the event triggers them again
+	ProcessedAt time.Time // time of the game clock, the replayed clocks run as they did
+}
+
+// append-only journal of the events processed by a game
+type EventLog struct {
+	Genesis *Snapshot // state of the game when its journal began
+	Entries []*LogEntry
+	mutex   sync.RWMutex
+}
+
+func (l *EventLog) append(event *Event, chained []*Event, processedAt time.Time) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.Entries = append(l.Entries, &LogEntry{Sequence: len(l.Entries) + 1, Event: event, Chained: chained, ProcessedAt: processedAt})
+}
+
+// returns a copy of the journal that is safe to read while the game goes on
+func (g *Game) EventLog() *EventLog {
+	if g.journal == nil {
+		return &EventLog{}
+	}
+	g.journal.mutex.RLock()
+	defer g.journal.mutex.RUnlock()
+	return &EventLog{Genesis: g.journal.Genesis, Entries: slices.Clone(g.journal.Entries)}
+}
+
+// rebuilds the game with every event of the log
+func Replay(log *EventLog) (*Game, error) {
+	return ReplayUntil(log, len(log.Entries))
+}
+
+// rebuilds the game as it was right after the event with the given sequence was processed,
+// sequence 0 returns the game as it started, the replayed game is there to be looked at so
+// it does not process new events
+func ReplayUntil(log *EventLog, sequence int) (*Game, error) {
+	if sequence < 0 || sequence > len(log.Entries) {
+		return nil, fmt.Errorf("invalid sequence %d: expected between 0 and %d", sequence, len(log.Entries))
+	}
+	if log.Genesis == nil {
+		return nil, errors.New("the log has no genesis, the game never started")
+	}
+
+	game, err := restoreGame(log.Genesis)
+	if err != nil {
+		return nil, err
+	}
+
+	// every event is processed again in this goroutine, the ones that failed must fail the same way
+	for _, entry := range log.Entries[:sequence] {
+		event := &Event{Type: entry.Event.Type, Timestamp: entry.Event.Timestamp, Payload: copyPayload(entry.Event.Payload)}
+		processedAt := entry.ProcessedAt
+		game.clock = func() time.Time { return processedAt }
+		err := game.dispatch(event)
+		game.trackTurn()
+		chained := len(game.chained)
+		game.chained = nil
+		if event.Status != entry.Event.Status {
+			return nil, fmt.Errorf("replayed event %d ended %s but it was recorded %s", entry.Sequence, event.Status, entry.Event.Status)
+		}
+		if err != nil && (entry.Event.Err == nil || err.Error() != entry.Event.Err.Error()) {
+			return nil, fmt.Errorf("replayed event %d failed with %q but it was recorded with %v", entry.Sequence, err, entry.Event.Err)
+		}
+		if chained != len(entry.Chained) {
+			return nil, fmt.Errorf("replayed event %d chained %d events but it was recorded with %d", entry.Sequence, chained, len(entry.Chained))
+		}
+	}
+	game.clock = nil
+	game.journal = &EventLog{Genesis: log.Genesis, Entries: slices.Clone(log.Entries[:sequence])}
+	return game, nil
+}
+
+// handlers fill in some payload fields, so replayed events get their own payload
+func copyPayload(payload Payload) Payload {
+	value := reflect.ValueOf(payload).Elem()
+	copied := reflect.New(value.Type())
+	copied.Elem().Set(value)
+	return copied.Interface().(Payload)
+}
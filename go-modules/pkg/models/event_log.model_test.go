@@ -0,0 +1,158 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// plays a few turns of a game that starts with Dian Keto and Sparks in the hands
+func playGameWithMagicCards(t *testing.T) *Game {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+
+	deckA := newDeckOfRealCards("PlayerA")
+	deckB := newDeckOfRealCards("PlayerB")
+	deckA.HandCards = newHand(t, 342) // Dian Keto the Cure Master
+	deckB.HandCards = newHand(t, 343) // Sparks
+	game, _ := NewGame([2]*Deck{deckA, deckB})
+	game.Start()
+
+	assert.NoError(t, game.NextPhase())
+	assert.NoError(t, game.ActivateMagicCard(deckA.HandCards[0]))
+	assert.NoError(t, game.NextPhase())
+	assert.NoError(t, game.NextPhase())
+	_, err := game.NextTurn()
+	assert.NoError(t, err)
+
+	// a rejected event is also part of the log
+	event, _ := NewEvent(&PlayerLifePointsUpdatePayload{PlayerIndex: PLAYER_B, Points: -1})
+	_, err = game.AddEventAndWait(context.Background(), event)
+	assert.Error(t, err)
+
+	assert.NoError(t, game.NextPhase())
+	assert.NoError(t, game.ActivateMagicCard(deckB.HandCards[0]))
+	assert.NoError(t, game.NextPhase())
+	return game
+}
+
+func TestEventLogRecordsProcessedEvents(t *testing.T) {
+	game := playGameWithMagicCards(t)
+	log := game.EventLog()
+
+	assert.Equal(t, game.ID, log.Genesis.ID)
+	assert.Equal(t, 342, log.Genesis.Cards[log.Genesis.Decks[PLAYER_A].HandCards[0]].TemplateID)
+	assert.Equal(t, 40, len(log.Genesis.Decks[PLAYER_A].RemainingCards))
+	assert.Equal(t, 12, len(log.Entries))
+	for index, entry := range log.Entries {
+		assert.Equal(t, index+1, entry.Sequence)
+	}
+	assert.Equal(t, EventDeckShuffled, log.Entries[0].Event.Type)
+	assert.Equal(t, EventDeckShuffled, log.Entries[1].Event.Type)
+	assert.Equal(t, EventCardsDrawn, log.Entries[2].Event.Type)
+	assert.Equal(t, EventMagicCardActivated, log.Entries[4].Event.Type)
+	assert.Equal(t, EventPlayerLifePointsUpdate, log.Entries[8].Event.Type)
+	assert.Equal(t, SOEFailed, log.Entries[8].Event.Status)
+
+	// the events triggered by the handlers are recorded along with the event that triggered them
+	assert.Len(t, log.Entries[4].Chained, 1)
+	assert.Equal(t, EventPlayerLifePointsUpdate, log.Entries[4].Chained[0].Type)
+	assert.Equal(t, SOECompleted, log.Entries[4].Chained[0].Status)
+	assert.Len(t, log.Entries[10].Chained, 1)
+	assert.Equal(t, EventDirectDamageToLifePoints, log.Entries[10].Chained[0].Type)
+	assert.Empty(t, log.Entries[0].Chained)
+}
+
+func TestReplay(t *testing.T) {
+	game := playGameWithMagicCards(t)
+	log := game.EventLog()
+
+	replayed, err := Replay(log)
+	assert.NoError(t, err)
+	assert.Equal(t, game.ID, replayed.ID)
+	assert.Equal(t, 8950, replayed.Decks[PLAYER_A].Player.LifePoints)
+	assert.Equal(t, 8000, replayed.Decks[PLAYER_B].Player.LifePoints)
+	assert.Equal(t, game.CurrentTurn.PlayerIndex, replayed.CurrentTurn.PlayerIndex)
+	assert.Equal(t, game.CurrentTurn.Phase, replayed.CurrentTurn.Phase)
+	assert.Equal(t, templateIDs(game.Decks[PLAYER_B].HandCards), templateIDs(replayed.Decks[PLAYER_B].HandCards))
+	assert.Equal(t, 343, replayed.Decks[PLAYER_B].DestroyedCards[0].Template.ID)
+	assert.Equal(t, len(log.Entries), len(replayed.EventLog().Entries))
+
+	// the replayed game is not running so it does not take new events
+	event, _ := NewEvent(&PlayerLifePointsUpdatePayload{PlayerIndex: PLAYER_A, Points: 100})
+	assert.Error(t, replayed.AddEvent(event))
+
+	// right after Dian Keto was activated
+	replayed, err = ReplayUntil(log, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 9000, replayed.Decks[PLAYER_A].Player.LifePoints)
+	assert.Equal(t, 8000, replayed.Decks[PLAYER_B].Player.LifePoints)
+	assert.Equal(t, PLAYER_A, replayed.CurrentTurn.PlayerIndex)
+	assert.Equal(t, PlaceCardsPhase, replayed.CurrentTurn.Phase)
+
+	// as the game started
+	replayed, err = ReplayUntil(log, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 8000, replayed.Decks[PLAYER_A].Player.LifePoints)
+	assert.Equal(t, 342, replayed.Decks[PLAYER_A].HandCards[0].Template.ID)
+	assert.Equal(t, DrawCardsPhase, replayed.CurrentTurn.Phase)
+}
+
+func TestReplayStoredLog(t *testing.T) {
+	game := playGameWithMagicCards(t)
+
+	data, err := json.Marshal(game.EventLog())
+	assert.NoError(t, err)
+	log := &EventLog{}
+	assert.NoError(t, json.Unmarshal(data, log))
+	assert.Equal(t, SOEFailed, log.Entries[8].Event.Status)
+	assert.NotNil(t, log.Entries[8].Event.Err)
+
+	replayed, err := Replay(log)
+	assert.NoError(t, err)
+	assert.Equal(t, 8950, replayed.Decks[PLAYER_A].Player.LifePoints)
+	assert.Equal(t, 343, replayed.Decks[PLAYER_B].DestroyedCards[0].Template.ID)
+}
+
+func TestReplayWithInvalidLog(t *testing.T) {
+	game := playGameWithMagicCards(t)
+	log := game.EventLog()
+
+	_, err := ReplayUntil(log, len(log.Entries)+1)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid sequence")
+
+	_, err = Replay(&EventLog{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "has no genesis")
+
+	// a log whose events do not lead to the same outcome cannot be trusted
+	log.Entries[8] = &LogEntry{Sequence: 9, Event: &Event{
+		Type:    EventPlayerLifePointsUpdate,
+		Status:  SOEFailed,
+		Payload: &PlayerLifePointsUpdatePayload{PlayerIndex: PLAYER_B, Points: 1},
+	}}
+	_, err = Replay(log)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "replayed event 9 ended COMPLETED but it was recorded FAILED")
+
+	// the events must fail for the same reason
+	log.Entries[8].Event.Payload = &PlayerLifePointsUpdatePayload{PlayerIndex: PLAYER_B, Points: -1}
+	log.Entries[8].Event.Err = errors.New("the player has no life points left")
+	_, err = Replay(log)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "replayed event 9 failed with")
+
+	// and trigger the same events
+	log = game.EventLog()
+	log.Entries[4] = &LogEntry{Sequence: 5, Event: log.Entries[4].Event}
+	_, err = Replay(log)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "replayed event 5 chained 1 events but it was recorded with 0")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
@@ -0,0 +1,208 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// fuses two exact cards, the order of the materials does not matter
+type SpecificFusion struct {
+	MaterialIDs [2]int `yaml:"materialIDs"`
+	ResultID    int    `yaml:"resultID"`
+}
+
+// fuses any two monsters of the given types, the result is the weakest candidate whose
+// attack is above the attack of both materials, materials stronger than MaxAttack do not fuse
+type GenericFusion struct {
+	MaterialTypes [2]TypeCard `yaml:"materialTypes"`
+	MaxAttack     int         `yaml:"maxAttack"` // 0 means no limit
+	ResultIDs     []int       `yaml:"resultIDs"`
+}
+
+type fusionTable struct {
+	Specific []*SpecificFusion `yaml:"specific"`
+	Generic  []*GenericFusion  `yaml:"generic"`
+}
+
+// is the global registry of fusion rules
+type FusionRegistry struct {
+	specific map[[2]int]int
+	generic  []*GenericFusion
+}
+
+var (
+	fusionRegistry             *FusionRegistry
+	singletonForFusionRegistry sync.Once
+)
+
+func CleanFusionRegistry() {
+	mutex.Lock()
+	defer mutex.Unlock()
+	fusionRegistry = nil
+	singletonForFusionRegistry = sync.Once{}
+}
+
+// returns the singleton instance of the fusion registry
+func GetFusionRegistry() *FusionRegistry {
+	singletonForFusionRegistry.Do(func() {
+		fusionRegistry = &FusionRegistry{
+			specific: make(map[[2]int]int),
+		}
+	})
+	return fusionRegistry
+}
+
+func (r *FusionRegistry) LoadFusionsFromYAML(data []byte) error {
+	var table fusionTable
+	if err := yaml.Unmarshal(data, &table); err != nil {
+		return fmt.Errorf("unexpected error trying to load fusions from YAML data: %w", err)
+	}
+	for index, fusion := range table.Generic {
+		if err := fusion.validate(); err != nil {
+			return fmt.Errorf("invalid generic fusion %d: %w", index, err)
+		}
+	}
+
+	for _, fusion := range table.Specific {
+		r.specific[fusionKey(fusion.MaterialIDs[0], fusion.MaterialIDs[1])] = fusion.ResultID
+	}
+	r.generic = append(r.generic, table.Generic...)
+	fmt.Printf("Fusion registry has a total of %d specific and %d generic fusions loaded\n", len(r.specific), len(r.generic))
+	return nil
+}
+
+// returns the template ID produced by fusing both cards, 0 means the fusion fails
+func (r *FusionRegistry) GetFusionResult(a, b *CardTemplate) int {
+	if resultID, exists := r.specific[fusionKey(a.ID, b.ID)]; exists {
+		return resultID
+	}
+
+	if !validMonsterTypes[a.Type] || !validMonsterTypes[b.Type] {
+		return 0
+	}
+
+	threshold := max(a.BaseAttack, b.BaseAttack)
+	bestID := 0
+	bestAttack := 0
+	for _, fusion := range r.generic {
+		if !fusion.matches(a.Type, b.Type) || (fusion.MaxAttack > 0 && threshold > fusion.MaxAttack) {
+			continue
+		}
+		for _, resultID := range fusion.ResultIDs {
+			result := GetCardRegistry().GetCard(resultID)
+			if result == nil || result.BaseAttack <= threshold {
+				continue
+			}
+			if bestID == 0 || result.BaseAttack < bestAttack {
+				bestID = result.ID
+				bestAttack = result.BaseAttack
+			}
+		}
+	}
+	return bestID
+}
+
+func (f *GenericFusion) validate() error {
+	for index, materialType := range f.MaterialTypes {
+		if !validMonsterTypes[materialType] {
+			return fmt.Errorf("material %d has an unknown monster type %q", index, materialType)
+		}
+	}
+	if f.MaxAttack < 0 {
+		return fmt.Errorf("negative max attack %d", f.MaxAttack)
+	}
+	if len(f.ResultIDs) == 0 {
+		return errors.New("no result IDs")
+	}
+	return nil
+}
+
+func (f *GenericFusion) matches(a, b TypeCard) bool {
+	return (f.MaterialTypes[0] == a && f.MaterialTypes[1] == b) ||
+		(f.MaterialTypes[0] == b && f.MaterialTypes[1] == a)
+}
+
+func fusionKey(a, b int) [2]int {
+	if a > b {
+		return [2]int{b, a}
+	}
+	return [2]int{a, b}
+}
+
+// an equip card chained with a monster is attached to it instead of being fused
+func splitEquipStep(a, b *CardInstance) (*CardInstance, *CardInstance, bool) {
+	if a.Template.Type == TypeEquip && validMonsterTypes[b.Template.Type] {
+		return b, a, true
+	}
+	if b.Template.Type == TypeEquip && validMonsterTypes[a.Template.Type] {
+		return a, b, true
+	}
+	return nil, nil, false
+}
+
+// chains the selected hand cards from left to right like the PS1 game does,
+// when two cards cannot be fused the left one is discarded and the chain goes on
+// with the right one, equip cards power up a copy of the monster they are chained with,
+// the returned events record the chain and must be dispatched in the given order
+func Fuse(hand []*CardInstance, order []int) (*CardInstance, []*Event, error) {
+	if len(order) == 0 {
+		return nil, nil, errors.New("at least one card must be selected")
+	}
+
+	used := make(map[int]bool, len(order))
+	for _, index := range order {
+		if index < 0 || index >= len(hand) {
+			return nil, nil, fmt.Errorf("invalid hand index: %d", index)
+		}
+		if used[index] {
+			return nil, nil, fmt.Errorf("hand index %d selected more than once", index)
+		}
+		used[index] = true
+	}
+
+	current := hand[order[0]]
+	events := []*Event{}
+	for _, index := range order[1:] {
+		next := hand[index]
+		if monster, equip, isEquipStep := splitEquipStep(current, next); isEquipStep {
+			if equipped, event, err := Equip(equip, monster); err == nil {
+				events = append(events, event)
+				current = equipped
+				continue
+			}
+		}
+
+		resultID := GetFusionRegistry().GetFusionResult(current.Template, next.Template)
+		if resultID == 0 {
+			event, err := NewEvent(&CardFusionFailedPayload{
+				MaterialIDs: [2]int{current.Template.ID, next.Template.ID},
+				DiscardedID: current.Template.ID,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			events = append(events, event)
+			current = next
+			continue
+		}
+
+		result, err := NewCardInstance(resultID)
+		if err != nil {
+			return nil, nil, err
+		}
+		event, err := NewEvent(&CardFusedPayload{
+			MaterialIDs: [2]int{current.Template.ID, next.Template.ID},
+			ResultID:    resultID,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		events = append(events, event)
+		current = result
+	}
+
+	return current, events, nil
+}
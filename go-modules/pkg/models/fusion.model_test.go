@@ -0,0 +1,171 @@
+package models
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var singletonForFusionModel sync.Once
+
+func initializeFusionTestSuite() {
+	singletonForFusionModel.Do(func() {
+		_, filename, _, _ := runtime.Caller(0)
+		fmt.Println("This setup code executes only one time for the file", filepath.Base(filename))
+		CleanFusionRegistry()
+	})
+}
+
+func LoadRealFusionsFromYAML() {
+	data, _ := os.ReadFile("../utils/fusions.yaml")
+	CleanFusionRegistry()
+	GetFusionRegistry().LoadFusionsFromYAML(data)
+}
+
+func newHand(t *testing.T, templateIDs ...int) []*CardInstance {
+	hand := []*CardInstance{}
+	for _, templateID := range templateIDs {
+		card, err := NewCardInstance(templateID)
+		assert.NoError(t, err)
+		hand = append(hand, card)
+	}
+	return hand
+}
+
+func TestLoadFusionsFromYAMLWithInvalidData(t *testing.T) {
+	initializeFusionTestSuite()
+	err := GetFusionRegistry().LoadFusionsFromYAML([]byte("specific: [materialIDs: ]]"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unexpected error trying to load fusions from YAML data")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+func TestFuseSpecificFusion(t *testing.T) {
+	initializeFusionTestSuite()
+	LoadReal722CardsFromYAML()
+	LoadRealFusionsFromYAML()
+
+	// Curse of Dragon + Gaia the Fierce Knight, the order does not matter
+	hand := newHand(t, 39, 38)
+	result, events, err := Fuse(hand, []int{0, 1})
+	assert.NoError(t, err)
+	assert.Equal(t, 37, result.Template.ID) // Gaia the Dragon Champion
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, EventCardFused, events[0].Type)
+	assert.Equal(t, &CardFusedPayload{MaterialIDs: [2]int{39, 38}, ResultID: 37}, events[0].Payload)
+}
+
+func TestFuseGenericFusion(t *testing.T) {
+	initializeFusionTestSuite()
+	LoadReal722CardsFromYAML()
+	LoadRealFusionsFromYAML()
+
+	t.Run("should pick the weakest result above both materials", func(t *testing.T) {
+		// Baby Dragon (1200) + Oscillo Hero #2 (1000)
+		result, _, err := Fuse(newHand(t, 4, 45), []int{0, 1})
+		assert.NoError(t, err)
+		assert.Equal(t, 425, result.Template.ID) // Thunder Dragon (1600)
+
+		// Curse of Dragon (2000) + Oscillo Hero #2 (1000)
+		result, _, err = Fuse(newHand(t, 39, 45), []int{1, 0})
+		assert.NoError(t, err)
+		assert.Equal(t, 613, result.Template.ID) // Twin-headed Thunder Dragon (2800)
+	})
+
+	t.Run("should fail when no result is above both materials", func(t *testing.T) {
+		// Meteor B. Dragon (3500) + Oscillo Hero #2 (1000)
+		hand := newHand(t, 713, 45)
+		result, events, err := Fuse(hand, []int{0, 1})
+		assert.NoError(t, err)
+		assert.Equal(t, hand[1], result)
+		assert.Equal(t, 1, len(events))
+		assert.Equal(t, EventCardFusionFailed, events[0].Type)
+		assert.Equal(t, 713, events[0].Payload.(*CardFusionFailedPayload).DiscardedID)
+	})
+}
+
+func TestGenericFusionWithMaxAttack(t *testing.T) {
+	initializeFusionTestSuite()
+	LoadReal722CardsFromYAML()
+	registry := &FusionRegistry{specific: make(map[[2]int]int)}
+	data := `
+generic:
+  - materialTypes: ["Dragon", "Thunder"]
+    maxAttack: 1500
+    resultIDs: [425, 613] # Thunder Dragon, Twin-headed Thunder Dragon
+`
+	assert.NoError(t, registry.LoadFusionsFromYAML([]byte(data)))
+
+	dragon := &CardTemplate{ID: 1001, Type: TypeDragon, BaseAttack: 1200}
+	thunder := &CardTemplate{ID: 1002, Type: TypeThunder, BaseAttack: 1000}
+	assert.Equal(t, 425, registry.GetFusionResult(dragon, thunder))
+	assert.Equal(t, 425, registry.GetFusionResult(thunder, dragon))
+
+	strongDragon := &CardTemplate{ID: 1003, Type: TypeDragon, BaseAttack: 1600}
+	assert.Zero(t, registry.GetFusionResult(strongDragon, thunder))
+}
+
+func TestLoadFusionsFromYAMLWithInvalidGenericFusion(t *testing.T) {
+	registry := &FusionRegistry{specific: make(map[[2]int]int)}
+	err := registry.LoadFusionsFromYAML([]byte(`generic: [{materialTypes: ["Dragon", ""], resultIDs: [425]}]`))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid generic fusion 0: material 1 has an unknown monster type ""`)
+
+	err = registry.LoadFusionsFromYAML([]byte(`generic: [{materialTypes: ["Dragon", "Pyro"], maxAttack: -1, resultIDs: [168]}]`))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "negative max attack -1")
+	assert.Empty(t, registry.generic)
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+func TestFuseChainFromLeftToRight(t *testing.T) {
+	initializeFusionTestSuite()
+	LoadReal722CardsFromYAML()
+	LoadRealFusionsFromYAML()
+
+	// Mystical Elf + Baby Dragon fails, then Baby Dragon + Oscillo Hero #2 fuses
+	hand := newHand(t, 2, 4, 45)
+	result, events, err := Fuse(hand, []int{0, 1, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 425, result.Template.ID)
+	assert.Equal(t, 2, len(events))
+	assert.Equal(t, EventCardFusionFailed, events[0].Type)
+	assert.Equal(t, 2, events[0].Payload.(*CardFusionFailedPayload).DiscardedID)
+	assert.Equal(t, EventCardFused, events[1].Type)
+
+	// a single card is not a fusion at all
+	result, events, err = Fuse(hand, []int{2})
+	assert.NoError(t, err)
+	assert.Equal(t, hand[2], result)
+	assert.Empty(t, events)
+}
+
+func TestFuseWithInvalidOrder(t *testing.T) {
+	initializeFusionTestSuite()
+	LoadReal722CardsFromYAML()
+	hand := newHand(t, 4, 45)
+
+	_, _, err := Fuse(hand, []int{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "at least one card must be selected")
+
+	_, _, err = Fuse(hand, []int{0, 2})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid hand index")
+
+	_, _, err = Fuse(hand, []int{1, 1})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "selected more than once")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
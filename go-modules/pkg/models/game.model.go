@@ -1,8 +1,11 @@
 package models
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"math/rand/v2"
+	"sync"
 	"time"
 )
 
@@ -14,6 +17,19 @@ const (
 	GameFinished     GameState = "FINISHED"
 )
 
+// winner index of the games that ended without a winner
+const NoWinner = -1
+
+// how the duel was won
+type WinReason string
+
+const (
+	WinByLifePoints WinReason = "LIFE_POINTS" // the opponent ran out of life points
+	WinByDeckOut    WinReason = "DECK_OUT"    // the opponent had to draw from an empty deck
+	WinByTimeout    WinReason = "TIMEOUT"     // the game clock of the opponent ran out
+	WinByForfeit    WinReason = "FORFEIT"     // the opponent ran out of time in too many turns
+)
+
 type Game struct {
 	ID           string
 	Decks        [2]*Deck
@@ -22,7 +38,22 @@ type Game struct {
 	State        GameState
 	StartTime    time.Time
 	DuelDuration time.Duration
+	WinnerIndex  int           // NoWinner until a player wins the duel
+	WinReason    WinReason     // empty until a player wins the duel
+	TimeControl  TimeControl   // the clocks are off unless it is set before the game starts
+	Timeouts     [2]int        // turns of each player that ran out of time
+	Seed         [32]byte      // every random outcome of the duel comes from it
+	source       *rand.ChaCha8 // kept apart from rng so its state can be saved in snapshots
+	rng          *rand.Rand
+	clock        func() time.Time // time.Now unless another clock is set
+	clocks       gameClocks
 	eventChan    chan *Event
+	done         chan struct{} // closed once the game is finished, nothing is sent on it
+	mutex        sync.RWMutex  // held by the event goroutine while it processes an event
+	onProcessed  func(result EventResult)
+	journal      *EventLog
+	chained      []*Event // events the handlers dispatched while the current event was processed
+	dispatching  int      // events being dispatched, the first one is not chained to any other
 }
 
 func NewGame(decks [2]*Deck) (*Game, error) {
@@ -33,6 +64,10 @@ func NewGame(decks [2]*Deck) (*Game, error) {
 	decks[0].Player.LifePoints = 8000
 	decks[1].Player.LifePoints = 8000
 
+	seed, err := NewSeedSecret()
+	if err != nil {
+		return nil, err
+	}
 	turn, _ := NewTurn(decks[0].Player, 0)
 	game := &Game{
 		ID:          generateUUID(),
@@ -41,19 +76,73 @@ func NewGame(decks [2]*Deck) (*Game, error) {
 		CurrentTurn: turn,
 		State:       GameReadyToStart,
 		StartTime:   time.Now(),
+		WinnerIndex: NoWinner,
+		Seed:        seed,
 	}
 
 	return game, nil
 }
 
+// replaces the random seed of the game, for instance with the one agreed by both peers
+func (g *Game) SetSeed(seed [32]byte) error {
+	if g.State != GameReadyToStart {
+		return fmt.Errorf("the seed can only be set in the %s state, got: %s", GameReadyToStart, g.State)
+	}
+	g.Seed = seed
+	return nil
+}
+
+// starts the game and shuffles both decks with the seed of the game
 func (g *Game) Start() error {
+	if err := g.start(); err != nil {
+		return err
+	}
+
+	for playerIndex := range g.Decks {
+		event, err := NewEvent(&DeckShuffledPayload{PlayerIndex: playerIndex})
+		if err != nil {
+			return err
+		}
+		if _, err := g.AddEventAndWait(context.Background(), event); err != nil {
+			return err
+		}
+	}
+
+	// the first turn begins in its draw phase as every other turn
+	draw, err := g.drawEvent(g.CurrentTurn.PlayerIndex)
+	if err != nil || draw == nil {
+		return err
+	}
+	_, err = g.AddEventAndWait(context.Background(), draw)
+	return err
+}
+
+// starts the game without shuffling, the shuffles of a replayed game come from its log
+func (g *Game) start() error {
+	g.mutex.Lock()
 	if g.State != GameReadyToStart {
+		g.mutex.Unlock()
 		return fmt.Errorf("game cannot be started in its current state, expected: %s, got: %s", GameReadyToStart, g.State)
 	}
 
 	g.State = GameInProgress
-	g.StartTime = time.Now()
+	g.StartTime = g.now()
+	g.source = rand.NewChaCha8(g.Seed)
+	g.rng = rand.New(g.source)
+	g.mutex.Unlock()
+	return g.run()
+}
+
+// opens the event channel of a game in progress, its journal begins with the current state
+func (g *Game) run() error {
+	g.trackTurn()
+	genesis, err := g.snapshot()
+	if err != nil {
+		return err
+	}
 	g.eventChan = make(chan *Event)
+	g.done = make(chan struct{})
+	g.journal = &EventLog{Genesis: genesis}
 
 	// Launch the event processing goroutine
 	go g.processEvents()
@@ -61,57 +150,236 @@ func (g *Game) Start() error {
 	return nil
 }
 
+// finishes the game once the event in process is over, the events waiting to be added are rejected
 func (g *Game) Finish() error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return g.finish()
+}
+
+// the event channel is never closed because other goroutines may still be sending on it,
+// closing done tells them and the event goroutine that the game is over
+func (g *Game) finish() error {
 	if g.State != GameInProgress {
 		return fmt.Errorf("game cannot be finished in its current state, expected: %s, got: %s", GameInProgress, g.State)
 	}
 
 	g.State = GameFinished
-	g.DuelDuration = time.Since(g.StartTime)
-	close(g.eventChan)
+	g.DuelDuration = g.now().Sub(g.StartTime)
+	if g.done != nil {
+		close(g.done)
+	}
 	return nil
 }
 
+// returns the state of the game, it is safe to call while the game is processing events
+func (g *Game) GetState() GameState {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+	return g.State
+}
+
+// returns the index of the player in turn, it is safe to call while the game is processing events
+func (g *Game) GetCurrentPlayerIndex() int {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+	return g.CurrentTurn.PlayerIndex
+}
+
 // calling normal AddEvent(e) could return errors and will block the code execution until the event is consumed
 // in the other hand, calling go AddEvent(e) as a gorutine will execute the code in an async(non-blocking) way
 // on the background which sounds great but errors cannot be catched anymore.
+// errors returned by the event handlers are only reported by AddEventAndWait and OnEventProcessed.
 func (g *Game) AddEvent(event *Event) error {
+	return g.enqueue(context.Background(), event)
+}
+
+// adds the event and blocks until it is processed, the returned error is the one of
+// the handler so illegal moves can be rejected, or the context error when it is done first
+func (g *Game) AddEventAndWait(ctx context.Context, event *Event) (EventResult, error) {
+	event.done = make(chan struct{})
+	if err := g.enqueue(ctx, event); err != nil {
+		return EventResult{Event: event, Err: err}, err
+	}
+
+	select {
+	case <-event.done:
+		return EventResult{Event: event, Err: event.Err}, event.Err
+	case <-ctx.Done():
+		return EventResult{Event: event, Err: ctx.Err()}, ctx.Err()
+	}
+}
+
+// builds the event of a move while no event is processed and waits for it, the state of
+// the game can still change before the event is processed so its handler checks the move again,
+// there is nothing to wait for when no event is built
+func (g *Game) play(move func() (*Event, error)) error {
+	g.mutex.RLock()
+	event, err := move()
+	g.mutex.RUnlock()
+	if err != nil || event == nil {
+		return err
+	}
+	_, err = g.AddEventAndWait(context.Background(), event)
+	return err
+}
+
+// registers the callback receiving the result of every event added to the game, followed
+// by the results of the events its handlers chained,
+// it runs in the event processing goroutine so it should be set before the game starts,
+// events sent to the players must go through Event.ViewFor to mask the hidden cards
+func (g *Game) OnEventProcessed(callback func(result EventResult)) {
+	g.onProcessed = callback
+}
+
+func (g *Game) enqueue(ctx context.Context, event *Event) error {
 	// events can only be added after GameReadyToStart phase and prior to GameFinished phase
-	if g.State != GameInProgress {
+	if g.GetState() != GameInProgress || g.done == nil {
 		return fmt.Errorf("events can be added only during %s phase", GameInProgress)
 	}
-	event.Data["status"] = SOEEnqueued
-	g.eventChan <- event
-	return nil
+	event.Status = SOEEnqueued
+	select {
+	case g.eventChan <- event:
+		return nil
+	case <-g.done:
+		return fmt.Errorf("events can be added only during %s phase", GameInProgress)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
-// this is a forever loop running in the background if executed as gorutine
+// this is a forever loop running in the background if executed as gorutine, it ends with the game
 func (g *Game) processEvents() {
-	for event := range g.eventChan {
-		if processingEventFunction, functionExists := validEventTypes[event.Type]; functionExists {
-			event.Data["status"] = SOEProcessing
-			processingEventFunction(event)
-			event.Data["status"] = SOECompleted
+	for {
+		select {
+		case event := <-g.eventChan:
+			g.process(event)
+		case <-g.done:
+			return
 		}
 	}
 }
 
-func (g *Game) NextTurn() (*Deck, error) {
+// the game cannot be read or changed by other goroutines while the event is processed,
+// an event received right before the game finished is rejected
+func (g *Game) process(event *Event) {
+	var chained []*Event
+	g.mutex.Lock()
 	if g.State != GameInProgress {
-		return nil, fmt.Errorf("cannot advance turn in the current game state, expected: %s, got: %s", GameInProgress, g.State)
+		event.Status = SOEFailed
+		event.Err = fmt.Errorf("events can be added only during %s phase", GameInProgress)
+	} else {
+		g.chained = nil
+		g.dispatch(event)
+		g.trackTurn()
+		chained, g.chained = g.chained, nil
+		g.journal.append(event, chained, g.now())
 	}
+	g.mutex.Unlock()
 
-	if g.CurrentTurn.Phase != EndPhase {
-		return nil, fmt.Errorf("cannot advance turn in the current turn phase, expected: %s, got: %s", EndPhase, g.CurrentTurn.Phase)
+	// the chained events are reported right after the event that triggered them
+	if g.onProcessed != nil {
+		for _, processed := range append([]*Event{event}, chained...) {
+			g.onProcessed(EventResult{Event: processed, Err: processed.Err})
+		}
+	}
+	if event.done != nil {
+		close(event.done)
+	}
+}
+
+// processes the event right away in the current goroutine, handlers use it
+// to chain the events they trigger without going through the event channel
+func (g *Game) dispatch(event *Event) error {
+	if g.dispatching > 0 {
+		g.chained = append(g.chained, event)
+	}
+	g.dispatching++
+	defer func() { g.dispatching-- }()
+
+	processingEventFunction, functionExists := eventHandlers[event.Type]
+	if !functionExists {
+		event.Status = SOEFailed
+		event.Err = fmt.Errorf("invalid event type %q: no handler registered", event.Type)
+		return event.Err
+	}
+
+	event.Status = SOEProcessing
+	if err := processingEventFunction(g, event); err != nil {
+		event.Status = SOEFailed
+		event.Err = err
+		return err
+	}
+	event.Status = SOECompleted
+	return nil
+}
+
+// returns the event refilling the hand of the player up to MaxHandSize cards, the player
+// gets out of cards when there is nothing left to draw, nil when the hand is already full
+func (g *Game) drawEvent(playerIndex int) (*Event, error) {
+	deck := g.Decks[playerIndex]
+	count := MaxHandSize - len(deck.HandCards)
+	if count <= 0 {
+		return nil, nil
+	}
+	if len(deck.RemainingCards) == 0 {
+		return NewEvent(&GetOutOfCardsPayload{PlayerIndex: playerIndex})
 	}
+	return NewEvent(&CardsDrawnPayload{PlayerIndex: playerIndex, Count: min(count, len(deck.RemainingCards))})
+}
 
-	if g.CurrentTurn.CurrentPlayer.RemainingTurnsToAtack > 0 {
-		g.CurrentTurn.CurrentPlayer.RemainingTurnsToAtack -= 1
+// the opponent of the winner loses the duel and the game is over
+func (g *Game) declareWinner(winnerIndex int, reason WinReason) error {
+	loserIndex := (winnerIndex + 1) % 2
+	loses, err := NewEvent(&PlayerLosesPayload{PlayerIndex: loserIndex})
+	if err != nil {
+		return err
+	}
+	wins, err := NewEvent(&PlayerWinsPayload{PlayerIndex: winnerIndex, Reason: reason})
+	if err != nil {
+		return err
+	}
+	if err := g.dispatch(loses); err != nil {
+		return err
 	}
+	if err := g.dispatch(wins); err != nil {
+		return err
+	}
+	return g.finish()
+}
 
+func (g *Game) NextTurn() (*Deck, error) {
+	if err := g.play(g.nextTurnEvent); err != nil {
+		return nil, err
+	}
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+	return g.Decks[g.CurrentTurn.PlayerIndex], nil
+}
+
+func (g *Game) nextTurnEvent() (*Event, error) {
+	if g.State != GameInProgress {
+		return nil, fmt.Errorf("cannot advance turn in the current game state, expected: %s, got: %s", GameInProgress, g.State)
+	}
+	if g.CurrentTurn.Phase != EndPhase {
+		return nil, fmt.Errorf("cannot advance turn in the current turn phase, expected: %s, got: %s", EndPhase, g.CurrentTurn.Phase)
+	}
 	nextPlayerIndex := (g.CurrentTurn.PlayerIndex + 1) % 2
-	nextPlayer := g.Decks[nextPlayerIndex].Player
-	g.CurrentTurn, _ = NewTurn(nextPlayer, nextPlayerIndex)
+	return NewEvent(&TurnPhaseChangePayload{PlayerIndex: nextPlayerIndex, Phase: DrawCardsPhase})
+}
 
-	return g.Decks[nextPlayerIndex], nil
+// moves the turn of the current player to its next phase, NextTurn passes the turn once it is over
+func (g *Game) NextPhase() error {
+	return g.play(g.nextPhaseEvent)
+}
+
+func (g *Game) nextPhaseEvent() (*Event, error) {
+	if g.State != GameInProgress {
+		return nil, fmt.Errorf("cannot advance phase in the current game state, expected: %s, got: %s", GameInProgress, g.State)
+	}
+	nextPhase, exists := nextPhases[g.CurrentTurn.Phase]
+	if !exists {
+		return nil, fmt.Errorf("cannot advance to next phase because current phase is %s", g.CurrentTurn.Phase)
+	}
+	return NewEvent(&TurnPhaseChangePayload{PlayerIndex: g.CurrentTurn.PlayerIndex, Phase: nextPhase})
 }
@@ -1,7 +1,9 @@
 package models
 
 import (
+	"context"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -80,8 +82,14 @@ func TestFinishGame(t *testing.T) {
 	err = game.Finish()
 	assert.NoError(t, err)
 	assert.GreaterOrEqual(t, game.DuelDuration, 1*time.Nanosecond, "duel duration is at least 1 nano second")
-	_, successReadingEventFromChannel := <-game.eventChan
-	assert.False(t, successReadingEventFromChannel, "eventChan should be closed")
+	_, successReadingFromDone := <-game.done
+	assert.False(t, successReadingFromDone, "done should be closed")
+
+	// adding events after the game finished is an error rather than a send on a closed channel
+	event, _ := NewEvent(&DeckShuffledPayload{PlayerIndex: 0})
+	err = game.AddEvent(event)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "events can be added only during")
 
 	// finish the game twice causes an error too
 	err = game.Finish()
@@ -92,6 +100,29 @@ func TestFinishGame(t *testing.T) {
 	t.Logf("Error: %v", err)
 }
 
+func TestFinishGameWhileEventsAreAdded(t *testing.T) {
+	game := newGameInActionPhase()
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				event, _ := NewEvent(&PlayerLifePointsUpdatePayload{PlayerIndex: PLAYER_A, Points: 1})
+				if _, err := game.AddEventAndWait(context.Background(), event); err != nil {
+					return
+				}
+			}
+		}()
+	}
+
+	// the senders get an error instead of a panic once the game is finished
+	assert.NoError(t, game.Finish())
+	wg.Wait()
+	assert.Equal(t, GameFinished, game.GetState())
+}
+
 func TestNextTurnSuccess(t *testing.T) {
 	playerA, _ := NewPlayer("PlayerA")
 	playerB, _ := NewPlayer("PlayerB")
@@ -182,6 +213,11 @@ func TestNextTurnWithInvalidPhaseState(t *testing.T) {
 	t.Logf("Error: %v", err)
 }
 
+// a payload whose event type has no handler registered
+type unknownPayload struct{}
+
+func (*unknownPayload) EventType() EventType { return "TestEvent" }
+
 func TestAddEvent(t *testing.T) {
 	playerA, _ := NewPlayer("PlayerA")
 	playerB, _ := NewPlayer("PlayerB")
@@ -192,7 +228,7 @@ func TestAddEvent(t *testing.T) {
 	game, _ := NewGame([2]*Deck{deckA, deckB})
 
 	// Trying to create a sample event, but fail
-	event, err := NewEvent("TestEvent", map[string]any{"key": "value"})
+	event, err := NewEvent(&unknownPayload{})
 	assert.Nil(t, event)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid event type")
@@ -201,10 +237,10 @@ func TestAddEvent(t *testing.T) {
 	t.Logf("Error: %v", err)
 
 	// Again trying to create an event successfully
-	event, err = NewEvent(EventDeckShuffled, map[string]any{"deck": deckA})
+	event, err = NewEvent(&DeckShuffledPayload{PlayerIndex: PLAYER_A})
 	assert.NoError(t, err)
 	assert.NotNil(t, event)
-	assert.Equal(t, event.Data["status"], SOEPristine)
+	assert.Equal(t, event.Status, SOEPristine)
 
 	// Trying to add the event to the game, but failing
 	err = game.AddEvent(event)
@@ -215,10 +251,102 @@ func TestAddEvent(t *testing.T) {
 	game.Start()
 	assert.NotNil(t, game.eventChan)
 
+	processed := make(chan EventResult, 1)
+	game.OnEventProcessed(func(result EventResult) {
+		processed <- result
+	})
 	go game.AddEvent(event)
 
 	// wait for the event to be processed
-	time.Sleep(1 * time.Millisecond)
+	<-processed
+
+	assert.Equal(t, event.Status, SOECompleted)
+}
+
+func TestChainedEventsAreReported(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	deckA := newDeckOfRealCards("PlayerA")
+	deckA.HandCards = newHand(t, 343) // Sparks
+	game, _ := NewGame([2]*Deck{deckA, newDeckOfRealCards("PlayerB")})
+
+	reported := []EventType{}
+	game.OnEventProcessed(func(result EventResult) {
+		reported = append(reported, result.Event.Type)
+	})
+	assert.NoError(t, game.Start())
+	assert.NoError(t, game.NextPhase())
+	assert.NoError(t, game.ActivateMagicCard(deckA.HandCards[0]))
+
+	// the damage is reported right after the magic card that dealt it
+	assert.Equal(t, []EventType{EventCardsDrawn, EventTurnPhaseChange, EventMagicCardActivated, EventDirectDamageToLifePoints}, reported[len(reported)-4:])
+	assert.Equal(t, 7950, game.Decks[PLAYER_B].Player.LifePoints)
+}
+
+func TestAddEventAndWait(t *testing.T) {
+	game := newGameInActionPhase()
+	results := make(chan EventResult, 2)
+	game.OnEventProcessed(func(result EventResult) {
+		results <- result
+	})
+
+	event, _ := NewEvent(&PlayerLifePointsUpdatePayload{PlayerIndex: PLAYER_A, Points: 1000})
+	result, err := game.AddEventAndWait(context.Background(), event)
+	assert.NoError(t, err)
+	assert.Equal(t, event, result.Event)
+	assert.Equal(t, SOECompleted, event.Status)
+	assert.Equal(t, 9000, game.Decks[PLAYER_A].Player.LifePoints)
+	assert.Equal(t, result, <-results)
+
+	// the handler error is returned and recorded on the event
+	event, _ = NewEvent(&PlayerLifePointsUpdatePayload{PlayerIndex: PLAYER_A, Points: -1000})
+	result, err = game.AddEventAndWait(context.Background(), event)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "negative points are not allowed")
+	assert.Equal(t, SOEFailed, event.Status)
+	assert.Equal(t, err, event.Err)
+	assert.Equal(t, err, result.Err)
+	assert.Equal(t, result, <-results)
 
-	assert.Equal(t, event.Data["status"], SOECompleted)
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+func TestAddEventAndWaitWithContextDone(t *testing.T) {
+	playerA, _ := NewPlayer("PlayerA")
+	playerB, _ := NewPlayer("PlayerB")
+	deckA, _ := NewDeck(playerA, [40]*CardInstance{})
+	deckB, _ := NewDeck(playerB, [40]*CardInstance{})
+	game, _ := NewGame([2]*Deck{deckA, deckB})
+
+	event, _ := NewEvent(&DeckShuffledPayload{PlayerIndex: PLAYER_A})
+	_, err := game.AddEventAndWait(context.Background(), event)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprintf("events can be added only during %s phase", GameInProgress))
+
+	// nobody consumes the events of this game so the context expires first
+	game.State = GameInProgress
+	game.eventChan = make(chan *Event)
+	game.done = make(chan struct{})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	result, err := game.AddEventAndWait(ctx, event)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, err, result.Err)
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+func TestNextPhase(t *testing.T) {
+	game := newGameInActionPhase()
+	assert.NoError(t, game.NextPhase())
+	assert.Equal(t, EndPhase, game.CurrentTurn.Phase)
+
+	err := game.NextPhase()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot advance to next phase because current phase is END_PHASE")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
 }
@@ -0,0 +1,19 @@
+package models
+
+import "fmt"
+
+type GetOutOfCardsPayload struct {
+	PlayerIndex int // the player who had to draw from an empty deck
+}
+
+func (*GetOutOfCardsPayload) EventType() EventType { return EventGetOutOfCards }
+func (p *GetOutOfCardsPayload) player() int        { return p.PlayerIndex }
+
+func EventGetOutOfCardsFn(game *Game, payload *GetOutOfCardsPayload) error {
+	deck := game.Decks[payload.PlayerIndex]
+	if len(deck.RemainingCards) > 0 {
+		return fmt.Errorf("%s still has %d cards to draw", deck.Player.Username, len(deck.RemainingCards))
+	}
+	fmt.Printf("%s has no cards left to draw...\n", deck.Player.Username)
+	return game.declareWinner((payload.PlayerIndex+1)%2, WinByDeckOut)
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// plays the turn of player A until it is over
+func finishTurnOfPlayerA(t *testing.T, game *Game) {
+	for range 3 {
+		assert.NoError(t, game.Apply(&NextPhaseAction{PlayerIndex: PLAYER_A}))
+	}
+}
+
+func TestTurnBeginsByRefillingTheHand(t *testing.T) {
+	game := newGameWithFusionInHand(t)
+	deckB := game.Decks[PLAYER_B]
+	deckB.HandCards = deckB.RemainingCards[:1]
+	deckB.RemainingCards = deckB.RemainingCards[38:]
+
+	// only the 2 cards left are drawn
+	finishTurnOfPlayerA(t, game)
+	assert.NoError(t, game.Apply(&NextTurnAction{PlayerIndex: PLAYER_A}))
+	assert.Len(t, deckB.HandCards, 3)
+	assert.Empty(t, deckB.RemainingCards)
+	assert.Equal(t, GameInProgress, game.State)
+}
+
+func TestDeckOutLosesTheDuel(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+
+	// the deck of player B is empty from the start so the replay sees it too
+	deckB := newDeckOfRealCards("PlayerB")
+	deckB.RemainingCards = []*CardInstance{}
+	game, _ := NewGame([2]*Deck{newDeckOfRealCards("PlayerA"), deckB})
+	assert.NoError(t, game.Start())
+
+	finishTurnOfPlayerA(t, game)
+	assert.NoError(t, game.Apply(&NextTurnAction{PlayerIndex: PLAYER_A}))
+	assert.Equal(t, GameFinished, game.State)
+	assert.Equal(t, PLAYER_A, game.WinnerIndex)
+	assert.Equal(t, WinByDeckOut, game.WinReason)
+	assert.Equal(t, 1, deckB.Player.LossCount)
+	assert.Equal(t, 1, game.Decks[PLAYER_A].Player.WinCount)
+
+	replayed, err := Replay(game.EventLog())
+	assert.NoError(t, err)
+	assert.Equal(t, GameFinished, replayed.State)
+	assert.Equal(t, WinByDeckOut, replayed.WinReason)
+}
+
+func TestInvalidEventGetOutOfCardsFn(t *testing.T) {
+	game := newGameWithFusionInHand(t)
+
+	err := EventGetOutOfCardsFn(game, &GetOutOfCardsPayload{PlayerIndex: PLAYER_B})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "PlayerB still has 40 cards to draw")
+	assert.Equal(t, GameInProgress, game.State)
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
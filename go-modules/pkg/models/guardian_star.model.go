@@ -0,0 +1,60 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+)
+
+// represents the celestial patron a monster fights under
+type GuardianStar string
+
+const (
+	GuardianStarSun     GuardianStar = "Sun"
+	GuardianStarMoon    GuardianStar = "Moon"
+	GuardianStarVenus   GuardianStar = "Venus"
+	GuardianStarMercury GuardianStar = "Mercury"
+	GuardianStarMars    GuardianStar = "Mars"
+	GuardianStarJupiter GuardianStar = "Jupiter"
+	GuardianStarSaturn  GuardianStar = "Saturn"
+	GuardianStarUranus  GuardianStar = "Uranus"
+	GuardianStarPluto   GuardianStar = "Pluto"
+	GuardianStarNeptune GuardianStar = "Neptune"
+)
+
+// attack and defense points given for the battle to the monster with the advantage
+const GuardianStarBonus = 500
+
+// each star beats the next one in its cycle:
+// Sun > Moon > Venus > Mercury > Sun and Mars > Jupiter > Saturn > Uranus > Pluto > Neptune > Mars
+var guardianStarAdvantages = map[GuardianStar]GuardianStar{
+	GuardianStarSun:     GuardianStarMoon,
+	GuardianStarMoon:    GuardianStarVenus,
+	GuardianStarVenus:   GuardianStarMercury,
+	GuardianStarMercury: GuardianStarSun,
+	GuardianStarMars:    GuardianStarJupiter,
+	GuardianStarJupiter: GuardianStarSaturn,
+	GuardianStarSaturn:  GuardianStarUranus,
+	GuardianStarUranus:  GuardianStarPluto,
+	GuardianStarPluto:   GuardianStarNeptune,
+	GuardianStarNeptune: GuardianStarMars,
+}
+
+func (s GuardianStar) Beats(other GuardianStar) bool {
+	beaten, exists := guardianStarAdvantages[s]
+	return exists && beaten == other
+}
+
+// unknown guardian stars are rejected when the cards are validated
+func (s GuardianStar) IsValid() bool {
+	_, exists := guardianStarAdvantages[s]
+	return exists
+}
+
+// chooses which of the two guardian stars of the monster is active
+func (c *CardState) SetGuardianStar(star GuardianStar) error {
+	if !slices.Contains(c.Card.Template.GuardianStars, star) {
+		return fmt.Errorf("invalid guardian star %q: expected one of [%v]", star, c.Card.Template.GuardianStars)
+	}
+	c.GuardianStar = star
+	return nil
+}
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuardianStarBeats(t *testing.T) {
+	cycles := [][]GuardianStar{
+		{GuardianStarSun, GuardianStarMoon, GuardianStarVenus, GuardianStarMercury},
+		{GuardianStarMars, GuardianStarJupiter, GuardianStarSaturn, GuardianStarUranus, GuardianStarPluto, GuardianStarNeptune},
+	}
+	for _, cycle := range cycles {
+		for i, star := range cycle {
+			next := cycle[(i+1)%len(cycle)]
+			assert.True(t, star.Beats(next), "%s should beat %s", star, next)
+			assert.False(t, next.Beats(star), "%s should not beat %s", next, star)
+		}
+	}
+
+	// stars from different cycles never beat each other
+	assert.False(t, GuardianStarSun.Beats(GuardianStarMars))
+	assert.False(t, GuardianStarMars.Beats(GuardianStarSun))
+	assert.False(t, GuardianStar("").Beats(GuardianStarSun))
+}
+
+func TestGuardianStarIsValid(t *testing.T) {
+	assert.True(t, GuardianStarSun.IsValid())
+	assert.True(t, GuardianStarNeptune.IsValid())
+	assert.False(t, GuardianStar("Earth").IsValid())
+	assert.False(t, GuardianStar("").IsValid())
+}
+
+func TestSetGuardianStar(t *testing.T) {
+	state := &CardState{Card: &CardInstance{Template: &CardTemplate{
+		GuardianStars: []GuardianStar{GuardianStarMars, GuardianStarJupiter},
+	}}}
+
+	err := state.SetGuardianStar(GuardianStarJupiter)
+	assert.NoError(t, err)
+	assert.Equal(t, GuardianStarJupiter, state.GuardianStar)
+
+	err = state.SetGuardianStar(GuardianStarSun)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid guardian star")
+	assert.Equal(t, GuardianStarJupiter, state.GuardianStar)
+}
+
+func TestGuardianStarChosenWhenPlacingMonsters(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	board := NewBoard()
+
+	// Baby Dragon has Uranus and Sun as guardian stars
+	babyDragon, _ := NewCardInstance(4)
+	state := &CardState{Card: babyDragon, IndexPosition: 0}
+	err := board.SetCardAtIndexPosition(state, PLAYER_A)
+	assert.NoError(t, err)
+	assert.Equal(t, GuardianStarUranus, state.GuardianStar, "defaults to the first guardian star")
+
+	state = &CardState{Card: babyDragon, IndexPosition: 1, GuardianStar: GuardianStarSun}
+	err = board.SetCardAtIndexPosition(state, PLAYER_A)
+	assert.NoError(t, err)
+	assert.Equal(t, GuardianStarSun, state.GuardianStar)
+
+	state = &CardState{Card: babyDragon, IndexPosition: 2, GuardianStar: GuardianStarMoon}
+	err = board.SetCardAtIndexPosition(state, PLAYER_A)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid guardian star")
+	assert.Nil(t, board.MonsterZones[PLAYER_A][2])
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+func TestResolveBattleWithGuardianStarBonus(t *testing.T) {
+	// the attacker wins thanks to its guardian star
+	attacker := newMonsterState(1200, 0, true)
+	attacker.GuardianStar = GuardianStarSun
+	defender := newMonsterState(1500, 0, true)
+	defender.GuardianStar = GuardianStarMoon
+	assert.Equal(t, BattleResult{DefenderDestroyed: true, DefenderDamage: 200}, ResolveBattle(attacker, defender))
+
+	// the defender resists thanks to its guardian star
+	attacker = newMonsterState(1800, 0, true)
+	attacker.GuardianStar = GuardianStarNeptune
+	defender = newMonsterState(0, 1500, false)
+	defender.GuardianStar = GuardianStarPluto
+	assert.Equal(t, BattleResult{AttackerDamage: 200}, ResolveBattle(attacker, defender))
+
+	// the bonus does not stay on the cards after the battle
+	assert.Equal(t, 1800, attacker.Card.CurrentAttack)
+	assert.Equal(t, 1500, defender.Card.CurrentDefense)
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+type GuardianStarChangePayload struct {
+	PlayerIndex  int
+	Position     int
+	GuardianStar GuardianStar
+}
+
+func (*GuardianStarChangePayload) EventType() EventType { return EventGuardianStarChange }
+func (p *GuardianStarChangePayload) player() int        { return p.PlayerIndex }
+
+// only the player in turn can switch the star of one of its own monsters, while placing cards
+func EventGuardianStarChangeFn(game *Game, payload *GuardianStarChangePayload) error {
+	if err := game.checkMove(payload.PlayerIndex, "change a guardian star", PlaceCardsPhase); err != nil {
+		return err
+	}
+	state, err := game.Board.GetMonsterAtIndexPosition(payload.PlayerIndex, payload.Position)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		return errors.New("monster missing")
+	}
+
+	fmt.Printf("Changing the guardian star of %s to %s...\n", state.Card.Template.Name, payload.GuardianStar)
+	return state.SetGuardianStar(payload.GuardianStar)
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventGuardianStarChangeFnWithSuccess(t *testing.T) {
+	game := newGameInActionPhase()
+	game.CurrentTurn.Phase = PlaceCardsPhase
+	state := &CardState{
+		Card:         &CardInstance{Template: &CardTemplate{GuardianStars: []GuardianStar{GuardianStarMars, GuardianStarJupiter}}},
+		GuardianStar: GuardianStarMars,
+	}
+	placeMonster(game, PLAYER_A, 3, state)
+
+	err := EventGuardianStarChangeFn(game, &GuardianStarChangePayload{
+		PlayerIndex:  PLAYER_A,
+		Position:     3,
+		GuardianStar: GuardianStarJupiter,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, GuardianStarJupiter, state.GuardianStar)
+}
+
+func TestInvalidEventGuardianStarChangeFn(t *testing.T) {
+	game := newGameInActionPhase()
+	state := &CardState{
+		Card:         &CardInstance{Template: &CardTemplate{GuardianStars: []GuardianStar{GuardianStarMars, GuardianStarJupiter}}},
+		GuardianStar: GuardianStarMars,
+	}
+	placeMonster(game, PLAYER_B, 3, state)
+
+	err := EventGuardianStarChangeFn(game, &GuardianStarChangePayload{PlayerIndex: PLAYER_A, Position: 0})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot change a guardian star in the current turn phase")
+
+	// the monsters of the opponent are out of reach
+	game.CurrentTurn.Phase = PlaceCardsPhase
+	err = EventGuardianStarChangeFn(game, &GuardianStarChangePayload{PlayerIndex: PLAYER_B, Position: 3, GuardianStar: GuardianStarJupiter})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "it is not the turn of player 1")
+	assert.Equal(t, GuardianStarMars, state.GuardianStar)
+
+	err = EventGuardianStarChangeFn(game, &GuardianStarChangePayload{PlayerIndex: PLAYER_A, Position: 5})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid card index position")
+
+	err = EventGuardianStarChangeFn(game, &GuardianStarChangePayload{PlayerIndex: PLAYER_A, Position: 0})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "monster missing")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
@@ -0,0 +1,50 @@
+package models
+
+import "fmt"
+
+type MagicCardActivatedPayload struct {
+	PlayerIndex int
+	MagicCardID int // magic card in the hand of the player
+}
+
+func (*MagicCardActivatedPayload) EventType() EventType { return EventMagicCardActivated }
+func (p *MagicCardActivatedPayload) player() int        { return p.PlayerIndex }
+
+func EventMagicCardActivatedFn(game *Game, payload *MagicCardActivatedPayload) error {
+	if err := game.checkCardPlay(payload.PlayerIndex, "activate a magic card"); err != nil {
+		return err
+	}
+	magicCard, err := game.Decks[payload.PlayerIndex].GetHandCard(payload.MagicCardID)
+	if err != nil {
+		return err
+	}
+	if err := canActivate(magicCard); err != nil {
+		return err
+	}
+
+	for _, effect := range magicCard.Template.MagicEffects {
+		if err := effect.validate(); err != nil {
+			return fmt.Errorf("card %q cannot be activated: %w", magicCard.Template.Name, err)
+		}
+	}
+
+	fmt.Printf("Activating the magic card %s...\n", magicCard.Template.Name)
+	for _, effect := range magicCard.Template.MagicEffects {
+		effectEvents, err := game.magicEffectEvents(magicCard, effect, payload.PlayerIndex)
+		if err != nil {
+			return err
+		}
+		for _, effectEvent := range effectEvents {
+			if err := game.dispatch(effectEvent); err != nil {
+				return err
+			}
+		}
+	}
+
+	// field cards stay on the board until another field card replaces them
+	if _, err := GetFieldCardTerrain(magicCard); err != nil {
+		game.Decks[payload.PlayerIndex].DestroyCard(magicCard)
+	}
+	game.CurrentTurn.CardPlaced = true
+	return nil
+}
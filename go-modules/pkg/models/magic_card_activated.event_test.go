@@ -0,0 +1,67 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvalidEventMagicCardActivatedFn(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	game := newGameInActionPhase()
+	game.CurrentTurn.Phase = PlaceCardsPhase
+
+	// Dark Hole
+	err := EventMagicCardActivatedFn(game, &MagicCardActivatedPayload{PlayerIndex: PLAYER_A, MagicCardID: 336})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is not in the hand")
+
+	// Eternal Rest would otherwise be discarded without doing anything
+	game.Decks[PLAYER_A].HandCards = newHand(t, 656)
+	err = EventMagicCardActivatedFn(game, &MagicCardActivatedPayload{PlayerIndex: PLAYER_A, MagicCardID: 656})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "its effect is not supported")
+	assert.Len(t, game.Decks[PLAYER_A].HandCards, 1)
+	assert.False(t, game.CurrentTurn.CardPlaced)
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+func TestEventMagicCardActivatedFnChecksEveryEffectFirst(t *testing.T) {
+	game := newGameInActionPhase()
+	game.CurrentTurn.Phase = PlaceCardsPhase
+	magicCard := &CardInstance{Template: &CardTemplate{ID: 9000, Name: "Half Spell", Type: TypeMagic, MagicEffects: []*MagicEffect{
+		{Kind: EffectLifePoints, Target: TargetSelf, Points: 1000},
+		{Kind: EffectProhibitAttack, Target: TargetOpponent},
+	}}}
+	game.Decks[PLAYER_A].HandCards = []*CardInstance{magicCard}
+
+	// the healing is valid but it is not applied because the second effect is not
+	err := EventMagicCardActivatedFn(game, &MagicCardActivatedPayload{PlayerIndex: PLAYER_A, MagicCardID: 9000})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "must last at least 1 turn")
+	assert.Equal(t, 8000, game.Decks[PLAYER_A].Player.LifePoints)
+	assert.Equal(t, []*CardInstance{magicCard}, game.Decks[PLAYER_A].HandCards)
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+func TestEventMagicCardActivatedFnPlaysTheCardOfTheTurn(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	game := newGameInActionPhase()
+	game.CurrentTurn.Phase = PlaceCardsPhase
+	game.Decks[PLAYER_A].HandCards = newHand(t, 342, 342) // Dian Keto the Cure Master
+
+	assert.NoError(t, EventMagicCardActivatedFn(game, &MagicCardActivatedPayload{PlayerIndex: PLAYER_A, MagicCardID: 342}))
+	assert.True(t, game.CurrentTurn.CardPlaced)
+
+	err := EventMagicCardActivatedFn(game, &MagicCardActivatedPayload{PlayerIndex: PLAYER_A, MagicCardID: 342})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "only one card can be placed per turn")
+	assert.Equal(t, 9000, game.Decks[PLAYER_A].Player.LifePoints)
+	assert.Len(t, game.Decks[PLAYER_A].HandCards, 1)
+}
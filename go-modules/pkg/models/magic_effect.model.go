@@ -0,0 +1,244 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+)
+
+// represents what a magic card does once activated
+type MagicEffectKind string
+
+const (
+	EffectDestroyMonsters   MagicEffectKind = "DESTROY_MONSTERS"
+	EffectDestroyMagicTraps MagicEffectKind = "DESTROY_MAGIC_TRAPS"
+	EffectLifePoints        MagicEffectKind = "LIFE_POINTS"
+	EffectStatChange        MagicEffectKind = "STAT_CHANGE"
+	EffectProhibitAttack    MagicEffectKind = "PROHIBIT_ATTACK"
+	EffectChangeField       MagicEffectKind = "CHANGE_FIELD"
+	EffectChangePosition    MagicEffectKind = "CHANGE_POSITION"
+	EffectReveal            MagicEffectKind = "REVEAL"
+)
+
+// represents whose side of the board a magic effect is applied to
+type EffectTarget string
+
+const (
+	TargetSelf     EffectTarget = "SELF"
+	TargetOpponent EffectTarget = "OPPONENT"
+	TargetBoth     EffectTarget = "BOTH"
+)
+
+// declares one effect of a magic card, the fields used depend on the kind of effect
+type MagicEffect struct {
+	Kind      MagicEffectKind `yaml:"kind"`
+	Target    EffectTarget    `yaml:"target"`
+	Types     []TypeCard      `yaml:"types,omitempty"` // only monsters of these types, empty means all
+	MinAttack int             `yaml:"minAttack,omitempty"`
+	Points    int             `yaml:"points,omitempty"` // positive values heal or power up
+	Turns     int             `yaml:"turns,omitempty"`
+	Terrain   Terrain         `yaml:"terrain,omitempty"`
+	ToAttack  bool            `yaml:"toAttack,omitempty"` // the monsters change to attack mode, otherwise to defense mode
+}
+
+// identifies a slot of the board
+type BoardPosition struct {
+	PlayerIndex int
+	Position    int
+}
+
+// checks every slot before a bulk event changes any of them
+func checkBoardPositions(positions []BoardPosition) error {
+	for _, slot := range positions {
+		if err := checkBoardPosition(slot.PlayerIndex, slot.Position); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// returns the indexes of the players affected by the effect
+func (e *MagicEffect) targetPlayers(playerIndex int) []int {
+	opponentIndex := (playerIndex + 1) % 2
+	switch e.Target {
+	case TargetSelf:
+		return []int{playerIndex}
+	case TargetOpponent:
+		return []int{opponentIndex}
+	case TargetBoth:
+		return []int{playerIndex, opponentIndex}
+	}
+	return nil
+}
+
+// checks the fields the kind of effect relies on, the effects of a card are all
+// checked before the first one is applied so a card is never resolved halfway
+func (e *MagicEffect) validate() error {
+	switch e.Kind {
+	case EffectChangeField:
+		if e.Terrain == "" {
+			return fmt.Errorf("magic effect %s has no terrain", e.Kind)
+		}
+		return nil
+	case EffectProhibitAttack:
+		if e.Turns <= 0 {
+			return fmt.Errorf("magic effect %s must last at least 1 turn, got %d", e.Kind, e.Turns)
+		}
+	case EffectDestroyMonsters, EffectDestroyMagicTraps, EffectLifePoints, EffectStatChange, EffectChangePosition, EffectReveal:
+	default:
+		return fmt.Errorf("invalid magic effect kind %q", e.Kind)
+	}
+	if e.targetPlayers(0) == nil {
+		return fmt.Errorf("invalid target %q of magic effect %s", e.Target, e.Kind)
+	}
+	return nil
+}
+
+// checks the monster against the type and attack filters of the effect
+func (e *MagicEffect) matches(monster *CardInstance) bool {
+	if len(e.Types) > 0 && !slices.Contains(e.Types, monster.Template.Type) {
+		return false
+	}
+	return monster.CurrentAttack >= e.MinAttack
+}
+
+// activates a magic card of the current player from its hand
+func (g *Game) ActivateMagicCard(card *CardInstance) error {
+	return g.play(func() (*Event, error) {
+		return g.magicCardEvent(card)
+	})
+}
+
+func (g *Game) magicCardEvent(card *CardInstance) (*Event, error) {
+	if err := g.checkCardPlay(g.CurrentTurn.PlayerIndex, "activate a magic card"); err != nil {
+		return nil, err
+	}
+	if err := canActivate(card); err != nil {
+		return nil, err
+	}
+	if !slices.Contains(g.Decks[g.CurrentTurn.PlayerIndex].HandCards, card) {
+		return nil, fmt.Errorf("card %q is not in the hand", card.Template.Name)
+	}
+
+	return NewEvent(&MagicCardActivatedPayload{
+		PlayerIndex: g.CurrentTurn.PlayerIndex,
+		MagicCardID: card.Template.ID,
+	})
+}
+
+// checks that the card is a magic card the engine can resolve, Cursebreaker and Eternal Rest
+// are rejected because their effects are not supported yet
+func canActivate(card *CardInstance) error {
+	if card.Template.Type != TypeMagic {
+		return fmt.Errorf("card %q is not a magic card", card.Template.Name)
+	}
+	if len(card.Template.MagicEffects) == 0 {
+		return fmt.Errorf("card %q cannot be activated: its effect is not supported", card.Template.Name)
+	}
+	return nil
+}
+
+// translates a magic effect into the typed events that resolve it
+func (g *Game) magicEffectEvents(card *CardInstance, effect *MagicEffect, playerIndex int) ([]*Event, error) {
+	targets := effect.targetPlayers(playerIndex)
+	switch effect.Kind {
+	case EffectDestroyMonsters:
+		event, err := NewEvent(&BulkCardDestructionPayload{
+			Zone:      ZoneMonster,
+			Positions: g.findMonsters(targets, effect),
+		})
+		return []*Event{event}, err
+
+	case EffectDestroyMagicTraps:
+		positions := []BoardPosition{}
+		for _, target := range targets {
+			for position, state := range g.Board.MagicTrapZones[target] {
+				if state != nil {
+					positions = append(positions, BoardPosition{PlayerIndex: target, Position: position})
+				}
+			}
+		}
+		event, err := NewEvent(&BulkCardDestructionPayload{
+			Zone:      ZoneMagicTrap,
+			Positions: positions,
+		})
+		return []*Event{event}, err
+
+	case EffectStatChange:
+		event, err := NewEvent(&BulkCardPointsUpdatePayload{
+			Positions: g.findMonsters(targets, effect),
+			Points:    effect.Points,
+		})
+		return []*Event{event}, err
+
+	case EffectLifePoints:
+		events := []*Event{}
+		for _, target := range targets {
+			event, err := g.lifePointsEvent(target, effect.Points)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, event)
+		}
+		return events, nil
+
+	case EffectProhibitAttack:
+		events := []*Event{}
+		for _, target := range targets {
+			event, err := NewEvent(&ProhibitOpponentToAtackPayload{
+				OpponentIndex: target,
+				Turns:         effect.Turns,
+			})
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, event)
+		}
+		return events, nil
+
+	case EffectChangePosition:
+		toAttack := effect.ToAttack
+		event, err := NewEvent(&BulkCardStateAndPositionChangedPayload{
+			Positions:      g.findMonsters(targets, effect),
+			IsInAttackMode: &toAttack,
+		})
+		return []*Event{event}, err
+
+	case EffectReveal:
+		faceUp := true
+		event, err := NewEvent(&BulkCardStateAndPositionChangedPayload{
+			Positions: g.findMonsters(targets, effect),
+			FaceUp:    &faceUp,
+		})
+		return []*Event{event}, err
+
+	case EffectChangeField:
+		event, err := NewEvent(&ChangeFieldLandPayload{
+			PlayerIndex: playerIndex,
+			FieldCardID: card.Template.ID,
+		})
+		return []*Event{event}, err
+	}
+
+	return nil, fmt.Errorf("invalid magic effect kind %q", effect.Kind)
+}
+
+// healing and damage are resolved by different events
+func (g *Game) lifePointsEvent(playerIndex, points int) (*Event, error) {
+	if points < 0 {
+		return NewEvent(&DirectDamageToLifePointsPayload{PlayerIndex: playerIndex, Damage: -points})
+	}
+	return NewEvent(&PlayerLifePointsUpdatePayload{PlayerIndex: playerIndex, Points: points})
+}
+
+// returns the positions of the monsters of the players that match the effect filters
+func (g *Game) findMonsters(playerIndexes []int, effect *MagicEffect) []BoardPosition {
+	positions := []BoardPosition{}
+	for _, playerIndex := range playerIndexes {
+		for position, state := range g.Board.MonsterZones[playerIndex] {
+			if state != nil && effect.matches(state.Card) {
+				positions = append(positions, BoardPosition{PlayerIndex: playerIndex, Position: position})
+			}
+		}
+	}
+	return positions
+}
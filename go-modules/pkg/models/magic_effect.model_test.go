@@ -0,0 +1,225 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTypedMonsterState(cardType TypeCard, attack int) *CardState {
+	state := newMonsterState(attack, attack, true)
+	state.Card.Template.Type = cardType
+	return state
+}
+
+// activates the magic card of the current player right away without the event channel,
+// as if it were the only card played in the turn
+func activateMagicCardNow(t *testing.T, game *Game, templateID int) *CardInstance {
+	magicCard, err := NewCardInstance(templateID)
+	assert.NoError(t, err)
+	game.Decks[game.CurrentTurn.PlayerIndex].HandCards = append(game.Decks[game.CurrentTurn.PlayerIndex].HandCards, magicCard)
+	game.CurrentTurn.Phase = PlaceCardsPhase
+	game.CurrentTurn.CardPlaced = false
+	event, _ := NewEvent(&MagicCardActivatedPayload{
+		PlayerIndex: game.CurrentTurn.PlayerIndex,
+		MagicCardID: templateID,
+	})
+	game.dispatch(event)
+	return magicCard
+}
+
+func TestMagicEffectsLoadedFromYAML(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+
+	withEffects := 0
+	for id := 1; id <= 722; id++ {
+		template := GetCardRegistry().GetCard(id)
+		if template != nil && template.Type == TypeMagic && len(template.MagicEffects) > 0 {
+			withEffects++
+		}
+	}
+	assert.Equal(t, 31, withEffects)
+
+	swords := GetCardRegistry().GetCard(348)
+	assert.Equal(t, []*MagicEffect{{Kind: EffectProhibitAttack, Target: TargetOpponent, Turns: 3}}, swords.MagicEffects)
+}
+
+func TestActivateMagicCard(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	game := newGameInActionPhase()
+	dianKeto, _ := NewCardInstance(342)
+	game.Decks[PLAYER_A].HandCards = []*CardInstance{dianKeto}
+
+	err := game.ActivateMagicCard(dianKeto)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot activate a magic card in the current turn phase")
+
+	game.CurrentTurn.Phase = PlaceCardsPhase
+	cursebreaker, _ := NewCardInstance(655)
+	err = game.ActivateMagicCard(cursebreaker)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `card "Cursebreaker" cannot be activated: its effect is not supported`)
+
+	legendarySword, _ := NewCardInstance(301)
+	err = game.ActivateMagicCard(legendarySword)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is not a magic card")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+
+	err = game.ActivateMagicCard(dianKeto)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 9000, game.Decks[PLAYER_A].Player.LifePoints)
+	assert.Empty(t, game.Decks[PLAYER_A].HandCards)
+	assert.Equal(t, []*CardInstance{dianKeto}, game.Decks[PLAYER_A].DestroyedCards)
+}
+
+func TestMagicEffectsDestroyMonsters(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+
+	t.Run("should destroy only the opponent monsters with Raigeki", func(t *testing.T) {
+		game := newGameInActionPhase()
+		placeMonster(game, PLAYER_A, 0, newMonsterState(1000, 1000, true))
+		placeMonster(game, PLAYER_B, 0, newMonsterState(1000, 1000, true))
+		placeMonster(game, PLAYER_B, 3, newMonsterState(1000, 1000, false))
+		activateMagicCardNow(t, game, 337)
+		assert.Equal(t, 1, game.Board.CountMonsters(PLAYER_A))
+		assert.Equal(t, 0, game.Board.CountMonsters(PLAYER_B))
+		assert.Equal(t, 2, len(game.Decks[PLAYER_B].DestroyedCards))
+	})
+
+	t.Run("should destroy the monsters of both players with Dark Hole", func(t *testing.T) {
+		game := newGameInActionPhase()
+		placeMonster(game, PLAYER_A, 0, newMonsterState(1000, 1000, true))
+		placeMonster(game, PLAYER_B, 0, newMonsterState(1000, 1000, true))
+		activateMagicCardNow(t, game, 336)
+		assert.Equal(t, 0, game.Board.CountMonsters(PLAYER_A))
+		assert.Equal(t, 0, game.Board.CountMonsters(PLAYER_B))
+	})
+
+	t.Run("should filter by type with Warrior Elimination", func(t *testing.T) {
+		game := newGameInActionPhase()
+		placeMonster(game, PLAYER_B, 0, newTypedMonsterState(TypeWarrior, 1000))
+		placeMonster(game, PLAYER_B, 1, newTypedMonsterState(TypeDragon, 1000))
+		activateMagicCardNow(t, game, 653)
+		assert.Nil(t, game.Board.MonsterZones[PLAYER_B][0])
+		assert.NotNil(t, game.Board.MonsterZones[PLAYER_B][1])
+	})
+
+	t.Run("should filter by attack with Crush Card", func(t *testing.T) {
+		game := newGameInActionPhase()
+		placeMonster(game, PLAYER_B, 0, newMonsterState(1500, 0, true))
+		placeMonster(game, PLAYER_B, 1, newMonsterState(1499, 0, true))
+		activateMagicCardNow(t, game, 661)
+		assert.Nil(t, game.Board.MonsterZones[PLAYER_B][0])
+		assert.NotNil(t, game.Board.MonsterZones[PLAYER_B][1])
+	})
+
+	t.Run("should destroy the opponent magic and trap cards with Harpie's Feather Duster", func(t *testing.T) {
+		game := newGameInActionPhase()
+		trap := newTrapState(&TrapRules{Trigger: TriggerAttackDeclared, Effect: TrapEffectDestroy})
+		game.Board.MagicTrapZones[PLAYER_B][2] = trap
+		activateMagicCardNow(t, game, 672)
+		assert.Nil(t, game.Board.MagicTrapZones[PLAYER_B][2])
+		assert.Equal(t, []*CardInstance{trap.Card}, game.Decks[PLAYER_B].DestroyedCards)
+	})
+}
+
+func TestMagicEffectsChangePoints(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+
+	t.Run("should heal and damage life points", func(t *testing.T) {
+		game := newGameInActionPhase()
+		activateMagicCardNow(t, game, 342) // Dian Keto the Cure Master
+		assert.Equal(t, 9000, game.Decks[PLAYER_A].Player.LifePoints)
+
+		activateMagicCardNow(t, game, 347) // Tremendous Fire
+		assert.Equal(t, 8500, game.Decks[PLAYER_A].Player.LifePoints)
+		assert.Equal(t, 7000, game.Decks[PLAYER_B].Player.LifePoints)
+	})
+
+	t.Run("should weaken the opponent monsters with Shadow Spell", func(t *testing.T) {
+		game := newGameInActionPhase()
+		own := newMonsterState(1000, 1000, true)
+		opponent := newMonsterState(1000, 500, true)
+		placeMonster(game, PLAYER_A, 0, own)
+		placeMonster(game, PLAYER_B, 0, opponent)
+		activateMagicCardNow(t, game, 669)
+		assert.Equal(t, 1000, own.Card.CurrentAttack)
+		assert.Equal(t, 300, opponent.Card.CurrentAttack)
+		assert.Equal(t, 0, opponent.Card.CurrentDefense)
+	})
+
+	t.Run("should prohibit the opponent to attack with Swords of Revealing Light", func(t *testing.T) {
+		game := newGameInActionPhase()
+		activateMagicCardNow(t, game, 348)
+		assert.Equal(t, 3, game.Decks[PLAYER_B].Player.RemainingTurnsToAtack)
+		assert.Equal(t, 0, game.Decks[PLAYER_A].Player.RemainingTurnsToAtack)
+	})
+
+	t.Run("should change the terrain and keep the field card on the board", func(t *testing.T) {
+		game := newGameInActionPhase()
+		forest := activateMagicCardNow(t, game, 330)
+		assert.Equal(t, TerrainForest, game.Board.Terrain)
+		assert.Equal(t, forest, game.Board.FieldZone[PLAYER_A].Card)
+		assert.Empty(t, game.Decks[PLAYER_A].DestroyedCards)
+	})
+}
+
+func TestMagicEffectsChangeStateAndPosition(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+
+	t.Run("should turn the opponent monsters to attack mode with Stop Defense", func(t *testing.T) {
+		game := newGameInActionPhase()
+		own := newMonsterState(1000, 1000, false)
+		opponent := newMonsterState(1000, 1000, false)
+		opponent.FaceUp = false
+		placeMonster(game, PLAYER_A, 0, own)
+		placeMonster(game, PLAYER_B, 0, opponent)
+		activateMagicCardNow(t, game, 320)
+		assert.False(t, own.Card.IsInAttackMode)
+		assert.True(t, opponent.Card.IsInAttackMode)
+		assert.False(t, opponent.FaceUp)
+	})
+
+	t.Run("should turn the opponent dragons to defense mode with Dragon Capture Jar", func(t *testing.T) {
+		game := newGameInActionPhase()
+		dragon := newTypedMonsterState(TypeDragon, 1000)
+		warrior := newTypedMonsterState(TypeWarrior, 1000)
+		placeMonster(game, PLAYER_B, 0, dragon)
+		placeMonster(game, PLAYER_B, 1, warrior)
+		activateMagicCardNow(t, game, 329)
+		assert.False(t, dragon.Card.IsInAttackMode)
+		assert.True(t, warrior.Card.IsInAttackMode)
+	})
+
+	t.Run("should reveal the opponent monsters with Dark-piercing Light", func(t *testing.T) {
+		game := newGameInActionPhase()
+		own := newMonsterState(1000, 1000, false)
+		opponent := newMonsterState(1000, 1000, false)
+		own.FaceUp, opponent.FaceUp = false, false
+		placeMonster(game, PLAYER_A, 0, own)
+		placeMonster(game, PLAYER_B, 0, opponent)
+		activateMagicCardNow(t, game, 350)
+		assert.False(t, own.FaceUp)
+		assert.True(t, opponent.FaceUp)
+		assert.False(t, opponent.Card.IsInAttackMode, "revealed monsters keep their position")
+	})
+}
+
+func TestMagicEffectWithInvalidKind(t *testing.T) {
+	game := newGameInActionPhase()
+	_, err := game.magicEffectEvents(&CardInstance{}, &MagicEffect{Kind: "SUMMON_EXODIA"}, PLAYER_A)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid magic effect kind")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
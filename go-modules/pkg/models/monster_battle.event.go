@@ -0,0 +1,86 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+type MonsterBattlePayload struct {
+	PlayerIndex      int // owner of the attacker
+	AttackerPosition int
+	DefenderPosition int          // DirectAttack when attacking the life points of the opponent
+	Result           BattleResult // filled in once the battle is resolved
+}
+
+func (*MonsterBattlePayload) EventType() EventType { return EventMonsterBattle }
+func (p *MonsterBattlePayload) player() int        { return p.PlayerIndex }
+
+func EventMonsterBattleFn(game *Game, payload *MonsterBattlePayload) error {
+	if err := game.checkMove(payload.PlayerIndex, "attack", ActionPhase); err != nil {
+		return err
+	}
+	if turns := game.CurrentTurn.CurrentPlayer.RemainingTurnsToAtack; turns > 0 {
+		return fmt.Errorf("player cannot attack for %d more turns", turns)
+	}
+	attackerIndex := payload.PlayerIndex
+	defenderIndex := (attackerIndex + 1) % 2
+	attacker, err := game.Board.GetMonsterAtIndexPosition(attackerIndex, payload.AttackerPosition)
+	if err != nil {
+		return err
+	}
+	if attacker == nil {
+		return errors.New("attacker monster missing")
+	}
+	if !attacker.Card.IsInAttackMode {
+		return errors.New("monsters in defense mode cannot attack")
+	}
+	if game.CurrentTurn.Attacked[payload.AttackerPosition] {
+		return errAttackedThisTurn(payload.AttackerPosition)
+	}
+	if payload.DefenderPosition == DirectAttack {
+		if game.Board.CountMonsters(defenderIndex) > 0 {
+			return errors.New("cannot attack directly while the opponent has monsters")
+		}
+	} else if defender, err := game.Board.GetMonsterAtIndexPosition(defenderIndex, payload.DefenderPosition); err != nil {
+		return err
+	} else if defender == nil {
+		return errors.New("defender monster missing")
+	}
+	game.CurrentTurn.Attacked[payload.AttackerPosition] = true
+
+	// the defender traps are sprung as soon as the attack is declared
+	if err := game.fireTraps(TriggerAttackDeclared, defenderIndex, payload.AttackerPosition); err != nil {
+		return err
+	}
+	attacker = game.Board.MonsterZones[attackerIndex][payload.AttackerPosition]
+	if attacker == nil {
+		fmt.Println("The attacker was destroyed by a trap...")
+		return nil
+	}
+
+	// attacking reveals the attacker
+	attacker.FaceUp = true
+	if payload.DefenderPosition == DirectAttack {
+		fmt.Println("Attacking the opponent directly...")
+		return game.damagePlayer(defenderIndex, attacker.Card.CurrentAttack)
+	}
+
+	defender := game.Board.MonsterZones[defenderIndex][payload.DefenderPosition]
+
+	// face-down monsters are revealed when attacked
+	defender.FaceUp = true
+	result := ResolveBattle(attacker, defender)
+	payload.Result = result
+	fmt.Printf("%s attacks %s...\n", attacker.Card.Template.Name, defender.Card.Template.Name)
+
+	if result.AttackerDestroyed {
+		game.destroyMonster(attackerIndex, payload.AttackerPosition)
+	}
+	if result.DefenderDestroyed {
+		game.destroyMonster(defenderIndex, payload.DefenderPosition)
+	}
+	if err := game.damagePlayer(attackerIndex, result.AttackerDamage); err != nil {
+		return err
+	}
+	return game.damagePlayer(defenderIndex, result.DefenderDamage)
+}
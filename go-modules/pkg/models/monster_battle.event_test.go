@@ -0,0 +1,53 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventMonsterBattleFnWithSuccess(t *testing.T) {
+	game := newGameInActionPhase()
+	placeMonster(game, PLAYER_A, 0, newMonsterState(1000, 0, true))
+	placeMonster(game, PLAYER_B, 0, newMonsterState(1800, 0, true))
+
+	payload := &MonsterBattlePayload{PlayerIndex: PLAYER_A, AttackerPosition: 0, DefenderPosition: 0}
+	err := EventMonsterBattleFn(game, payload)
+	assert.NoError(t, err)
+	assert.Equal(t, BattleResult{AttackerDestroyed: true, AttackerDamage: 800}, payload.Result)
+	assert.True(t, game.CurrentTurn.Attacked[0])
+}
+
+func TestInvalidEventMonsterBattleFn(t *testing.T) {
+	game := newGameInActionPhase()
+	err := EventMonsterBattleFn(game, &MonsterBattlePayload{PlayerIndex: PLAYER_A, AttackerPosition: 0, DefenderPosition: 0})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "attacker monster missing")
+
+	placeMonster(game, PLAYER_A, 0, newMonsterState(1000, 0, true))
+	err = EventMonsterBattleFn(game, &MonsterBattlePayload{PlayerIndex: PLAYER_A, AttackerPosition: 0, DefenderPosition: 0})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "defender monster missing")
+
+	// positions outside the board are rejected instead of panicking
+	err = EventMonsterBattleFn(game, &MonsterBattlePayload{PlayerIndex: PLAYER_A, AttackerPosition: 5, DefenderPosition: 0})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid card index position: 5")
+	err = EventMonsterBattleFn(game, &MonsterBattlePayload{PlayerIndex: PLAYER_A, AttackerPosition: 0, DefenderPosition: -2})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid card index position: -2")
+	err = EventMonsterBattleFn(game, &MonsterBattlePayload{PlayerIndex: 7, AttackerPosition: 0, DefenderPosition: 0})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "it is not the turn of player 7")
+	assert.False(t, game.CurrentTurn.Attacked[0], "rejected attacks are not counted")
+
+	// each monster attacks once per turn
+	assert.NoError(t, EventMonsterBattleFn(game, &MonsterBattlePayload{PlayerIndex: PLAYER_A, AttackerPosition: 0, DefenderPosition: DirectAttack}))
+	err = EventMonsterBattleFn(game, &MonsterBattlePayload{PlayerIndex: PLAYER_A, AttackerPosition: 0, DefenderPosition: DirectAttack})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the monster at position 0 already attacked this turn")
+	assert.Equal(t, 7000, game.Decks[PLAYER_B].Player.LifePoints)
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+type OneCardStateAndPositionChangedPayload struct {
+	PlayerIndex    int // owner of the monster, it must be in turn
+	Position       int
+	IsInAttackMode bool
+	FaceUp         bool
+}
+
+func (*OneCardStateAndPositionChangedPayload) EventType() EventType {
+	return EventOneCardStateAndPositionChanged
+}
+
+func (p *OneCardStateAndPositionChangedPayload) player() int { return p.PlayerIndex }
+
+func EventOneCardStateAndPositionChangedFn(game *Game, payload *OneCardStateAndPositionChangedPayload) error {
+	if err := game.checkMove(payload.PlayerIndex, "change the position of a monster", PlaceCardsPhase, ActionPhase); err != nil {
+		return err
+	}
+	monster, err := game.Board.GetMonsterAtIndexPosition(payload.PlayerIndex, payload.Position)
+	if err != nil {
+		return err
+	}
+	if monster == nil {
+		return errors.New("monster missing")
+	}
+	if game.CurrentTurn.PositionChanged[payload.Position] {
+		return fmt.Errorf("the monster at position %d already changed its position this turn", payload.Position)
+	}
+
+	game.CurrentTurn.PositionChanged[payload.Position] = true
+	monster.Card.IsInAttackMode = payload.IsInAttackMode
+	monster.FaceUp = payload.FaceUp
+	fmt.Printf("%s changes to %s mode...\n", monster.Card.Template.Name, battleMode(payload.IsInAttackMode))
+	return nil
+}
+
+func battleMode(isInAttackMode bool) string {
+	if isInAttackMode {
+		return "attack"
+	}
+	return "defense"
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventOneCardStateAndPositionChangedFnWithSuccess(t *testing.T) {
+	game := newGameInActionPhase()
+	monster := newMonsterState(1000, 2000, false)
+	monster.FaceUp = false
+	placeMonster(game, PLAYER_A, 0, monster)
+
+	err := EventOneCardStateAndPositionChangedFn(game, &OneCardStateAndPositionChangedPayload{PlayerIndex: PLAYER_A, Position: 0, IsInAttackMode: true, FaceUp: true})
+	assert.NoError(t, err)
+	assert.True(t, monster.Card.IsInAttackMode)
+	assert.True(t, monster.FaceUp)
+
+	// a new turn allows a new change
+	game.CurrentTurn, _ = NewTurn(game.Decks[PLAYER_A].Player, PLAYER_A)
+	game.CurrentTurn.Phase = PlaceCardsPhase
+	err = EventOneCardStateAndPositionChangedFn(game, &OneCardStateAndPositionChangedPayload{PlayerIndex: PLAYER_A, Position: 0, FaceUp: true})
+	assert.NoError(t, err)
+	assert.False(t, monster.Card.IsInAttackMode)
+}
+
+func TestInvalidEventOneCardStateAndPositionChangedFn(t *testing.T) {
+	game := newGameInActionPhase()
+	placeMonster(game, PLAYER_A, 0, newMonsterState(1000, 2000, true))
+	placeMonster(game, PLAYER_B, 0, newMonsterState(1000, 2000, true))
+
+	err := EventOneCardStateAndPositionChangedFn(game, &OneCardStateAndPositionChangedPayload{PlayerIndex: PLAYER_B, Position: 0})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "it is not the turn of player 1")
+
+	err = EventOneCardStateAndPositionChangedFn(game, &OneCardStateAndPositionChangedPayload{PlayerIndex: PLAYER_A, Position: 3})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "monster missing")
+
+	assert.NoError(t, EventOneCardStateAndPositionChangedFn(game, &OneCardStateAndPositionChangedPayload{PlayerIndex: PLAYER_A, Position: 0}))
+	err = EventOneCardStateAndPositionChangedFn(game, &OneCardStateAndPositionChangedPayload{PlayerIndex: PLAYER_A, Position: 0, IsInAttackMode: true})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the monster at position 0 already changed its position this turn")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
@@ -34,6 +34,9 @@ const (
 
 var validSignUpCountries = []string{Canada, USA, Mexico, Colombia, Brazil, Chile, Peru, Aregentina}
 
+// ELO rating of every new player
+const InitialRating = 1500
+
 type Player struct {
 	ID                    string
 	Username              string
@@ -48,6 +51,7 @@ type Player struct {
 	TotalDuels            int
 	WinCount              int
 	LossCount             int
+	Rating                int // ELO rating used by the matchmaking
 }
 
 func NewPlayer(username string) (*Player, error) {
@@ -66,6 +70,7 @@ func NewPlayer(username string) (*Player, error) {
 		TotalDuels:   0,
 		WinCount:     0,
 		LossCount:    0,
+		Rating:       InitialRating,
 	}, nil
 }
 
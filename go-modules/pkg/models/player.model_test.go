@@ -55,6 +55,7 @@ func TestNewPlayer(t *testing.T) {
 				assert.Equal(t, 0, player.TotalDuels)
 				assert.Equal(t, 0, player.WinCount)
 				assert.Equal(t, 0, player.LossCount)
+				assert.Equal(t, InitialRating, player.Rating)
 
 				// Verify timestamps are initialized
 				assert.False(t, player.WhenSignedUp.IsZero(), "should not be January 1, year 1")
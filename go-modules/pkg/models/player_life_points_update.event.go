@@ -0,0 +1,26 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+type PlayerLifePointsUpdatePayload struct {
+	PlayerIndex int
+	Points      int
+}
+
+func (*PlayerLifePointsUpdatePayload) EventType() EventType { return EventPlayerLifePointsUpdate }
+func (p *PlayerLifePointsUpdatePayload) player() int        { return p.PlayerIndex }
+
+// heals the player, damage goes through EventDirectDamageToLifePoints instead
+func EventPlayerLifePointsUpdateFn(game *Game, payload *PlayerLifePointsUpdatePayload) error {
+	if payload.Points < 0 {
+		return errors.New("negative points are not allowed, use EventDirectDamageToLifePoints instead")
+	}
+
+	player := game.Decks[payload.PlayerIndex].Player
+	fmt.Printf("%s recovers %d life points...\n", player.Username, payload.Points)
+	player.LifePoints += payload.Points
+	return nil
+}
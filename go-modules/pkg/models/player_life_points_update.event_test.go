@@ -0,0 +1,18 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvalidEventPlayerLifePointsUpdateFn(t *testing.T) {
+	game := newGameInActionPhase()
+	err := EventPlayerLifePointsUpdateFn(game, &PlayerLifePointsUpdatePayload{PlayerIndex: PLAYER_A, Points: -100})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "negative points are not allowed")
+	assert.Equal(t, 8000, game.Decks[PLAYER_A].Player.LifePoints)
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
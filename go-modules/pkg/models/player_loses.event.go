@@ -0,0 +1,19 @@
+package models
+
+import "fmt"
+
+type PlayerLosesPayload struct {
+	PlayerIndex int
+}
+
+func (*PlayerLosesPayload) EventType() EventType { return EventPlayerLoses }
+func (p *PlayerLosesPayload) player() int        { return p.PlayerIndex }
+
+func EventPlayerLosesFn(game *Game, payload *PlayerLosesPayload) error {
+	player := game.Decks[payload.PlayerIndex].Player
+	fmt.Printf("%s loses the duel...\n", player.Username)
+	player.IsDueling = false
+	player.TotalDuels++
+	player.LossCount++
+	return nil
+}
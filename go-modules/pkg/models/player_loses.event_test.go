@@ -0,0 +1,19 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventPlayerLosesFnWithSuccess(t *testing.T) {
+	game := newGameInActionPhase()
+	player := game.Decks[PLAYER_B].Player
+	player.IsDueling = true
+
+	err := EventPlayerLosesFn(game, &PlayerLosesPayload{PlayerIndex: PLAYER_B})
+	assert.NoError(t, err)
+	assert.False(t, player.IsDueling)
+	assert.Equal(t, 1, player.TotalDuels)
+	assert.Equal(t, 1, player.LossCount)
+}
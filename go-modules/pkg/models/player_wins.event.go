@@ -0,0 +1,22 @@
+package models
+
+import "fmt"
+
+type PlayerWinsPayload struct {
+	PlayerIndex int
+	Reason      WinReason
+}
+
+func (*PlayerWinsPayload) EventType() EventType { return EventPlayerWins }
+func (p *PlayerWinsPayload) player() int        { return p.PlayerIndex }
+
+func EventPlayerWinsFn(game *Game, payload *PlayerWinsPayload) error {
+	player := game.Decks[payload.PlayerIndex].Player
+	fmt.Printf("%s wins the duel!\n", player.Username)
+	player.IsDueling = false
+	player.TotalDuels++
+	player.WinCount++
+	game.WinnerIndex = payload.PlayerIndex
+	game.WinReason = payload.Reason
+	return nil
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventPlayerWinsFnWithSuccess(t *testing.T) {
+	game := newGameInActionPhase()
+	player := game.Decks[PLAYER_B].Player
+	player.IsDueling = true
+	assert.Equal(t, NoWinner, game.WinnerIndex)
+
+	err := EventPlayerWinsFn(game, &PlayerWinsPayload{PlayerIndex: PLAYER_B, Reason: WinByLifePoints})
+	assert.NoError(t, err)
+	assert.False(t, player.IsDueling)
+	assert.Equal(t, 1, player.TotalDuels)
+	assert.Equal(t, 1, player.WinCount)
+	assert.Equal(t, PLAYER_B, game.WinnerIndex)
+	assert.Equal(t, WinByLifePoints, game.WinReason)
+}
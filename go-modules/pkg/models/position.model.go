@@ -0,0 +1,33 @@
+package models
+
+import "fmt"
+
+// switches a monster of the current player between attack and defense mode, which reveals it,
+// each monster can change its position once per turn
+func (g *Game) ChangePosition(position int) error {
+	return g.play(func() (*Event, error) {
+		return g.changePositionEvent(position)
+	})
+}
+
+func (g *Game) changePositionEvent(position int) (*Event, error) {
+	if err := g.checkMove(g.CurrentTurn.PlayerIndex, "change the position of a monster", PlaceCardsPhase, ActionPhase); err != nil {
+		return nil, err
+	}
+
+	playerIndex := g.CurrentTurn.PlayerIndex
+	monster, err := g.Board.GetMonsterAtIndexPosition(playerIndex, position)
+	if err != nil {
+		return nil, err
+	}
+	if monster == nil {
+		return nil, fmt.Errorf("there is no monster to change at position %d", position)
+	}
+
+	return NewEvent(&OneCardStateAndPositionChangedPayload{
+		PlayerIndex:    playerIndex,
+		Position:       position,
+		IsInAttackMode: !monster.Card.IsInAttackMode,
+		FaceUp:         true,
+	})
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangePosition(t *testing.T) {
+	game := newGameInActionPhase()
+	monster := newMonsterState(1000, 2000, true)
+	monster.FaceUp = false
+	placeMonster(game, PLAYER_A, 1, monster)
+
+	event, err := game.changePositionEvent(1)
+	assert.NoError(t, err)
+	assert.NoError(t, game.dispatch(event))
+	assert.False(t, monster.Card.IsInAttackMode)
+	assert.True(t, monster.FaceUp)
+	assert.True(t, game.CurrentTurn.PositionChanged[1])
+
+	// the limit is checked once the event is processed
+	event, err = game.changePositionEvent(1)
+	assert.NoError(t, err)
+	assert.Error(t, game.dispatch(event))
+	assert.False(t, monster.Card.IsInAttackMode)
+}
+
+func TestInvalidChangePosition(t *testing.T) {
+	game := newGameInActionPhase()
+
+	_, err := game.changePositionEvent(1)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "there is no monster to change at position 1")
+
+	_, err = game.changePositionEvent(5)
+	assert.Error(t, err)
+
+	game.CurrentTurn.Phase = EndPhase
+	err = game.ChangePosition(1)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot change the position of a monster in the current turn phase")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
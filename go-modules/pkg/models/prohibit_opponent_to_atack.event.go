@@ -1,30 +1,18 @@
 package models
 
-import (
-	"errors"
-	"fmt"
-)
+import "fmt"
 
-// The card 348 - Swords of Revealing Light trigger this event
-func EventProhibitOpponentToAtackFn(event *Event) error {
-	if event.Type != EventProhibitOpponentToAtack {
-		return fmt.Errorf("invalid event type %s: expected %s", event.Type, EventProhibitOpponentToAtack)
-	}
-	if event.Data["status"] != SOEProcessing {
-		return fmt.Errorf("invalid event status %s: expected %s", event.Data["status"], SOEProcessing)
-	}
+type ProhibitOpponentToAtackPayload struct {
+	OpponentIndex int
+	Turns         int
+}
 
-	// gathering requirements
-	turns, turnsExist := event.Data["turns"].(int)
-	if !turnsExist {
-		return errors.New("turns missing")
-	}
-	opponent, playerExists := event.Data["opponent"].(*Player)
-	if !playerExists {
-		return errors.New("opponent player missing")
-	}
+func (*ProhibitOpponentToAtackPayload) EventType() EventType { return EventProhibitOpponentToAtack }
+func (p *ProhibitOpponentToAtackPayload) player() int        { return p.OpponentIndex }
 
+// The card 348 - Swords of Revealing Light trigger this event
+func EventProhibitOpponentToAtackFn(game *Game, payload *ProhibitOpponentToAtackPayload) error {
 	fmt.Println("Prohibiting the Opponent To Atack...")
-	opponent.RemainingTurnsToAtack = turns
+	game.Decks[payload.OpponentIndex].Player.RemainingTurnsToAtack = payload.Turns
 	return nil
 }
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// activates a ritual card of the current player, the materials on its side of the board
+// are sacrificed and the ritual monster is summoned at the given position
+func (g *Game) ActivateRitual(ritual *CardInstance, position int) error {
+	return g.play(func() (*Event, error) {
+		return g.ritualEvent(ritual, position)
+	})
+}
+
+func (g *Game) ritualEvent(ritual *CardInstance, position int) (*Event, error) {
+	if err := g.checkCardPlay(g.CurrentTurn.PlayerIndex, "activate a ritual"); err != nil {
+		return nil, err
+	}
+	if _, err := g.findRitualMaterials(ritual, g.CurrentTurn.PlayerIndex, position); err != nil {
+		return nil, err
+	}
+	if !slices.Contains(g.Decks[g.CurrentTurn.PlayerIndex].HandCards, ritual) {
+		return nil, fmt.Errorf("card %q is not in the hand", ritual.Template.Name)
+	}
+
+	return NewEvent(&SacrificeCardsForRitualPayload{
+		PlayerIndex: g.CurrentTurn.PlayerIndex,
+		RitualID:    ritual.Template.ID,
+		Position:    position,
+	})
+}
+
+// returns the board positions of the monsters required by the ritual, the ritual
+// monster can only be summoned on an empty position or on the position of a material
+func (g *Game) findRitualMaterials(ritual *CardInstance, playerIndex, position int) ([]int, error) {
+	if ritual.Template.Type != TypeRitual || ritual.Template.RitualRules == nil {
+		return nil, fmt.Errorf("card %q is not a ritual card", ritual.Template.Name)
+	}
+	if position < 0 || position >= 5 {
+		return nil, fmt.Errorf("invalid card index position: %d", position)
+	}
+
+	positions := []int{}
+	taken := [5]bool{}
+	for _, materialID := range ritual.Template.RitualRules.Material {
+		found := false
+		for position, state := range g.Board.MonsterZones[playerIndex] {
+			if state != nil && !taken[position] && state.Card.Template.ID == materialID {
+				taken[position] = true
+				positions = append(positions, position)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.New("ritual materials missing on the board")
+		}
+	}
+	if g.Board.MonsterZones[playerIndex][position] != nil && !taken[position] {
+		return nil, fmt.Errorf("position %d is taken by a monster that is not a ritual material", position)
+	}
+	return positions, nil
+}
@@ -0,0 +1,130 @@
+package models
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRitualCardsLoadedFromYAML(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+
+	rituals := 0
+	materials := map[string]int{}
+	for id := 1; id <= 722; id++ {
+		template := GetCardRegistry().GetCard(id)
+		if template == nil || template.Type != TypeRitual {
+			continue
+		}
+		rituals++
+		assert.NotNil(t, template.RitualRules, "ritual card %d has no ritual rules", id)
+		assert.Equal(t, 3, len(template.RitualRules.Material))
+		assert.NotNil(t, GetCardRegistry().GetCard(template.RitualRules.ResultID))
+
+		// two rituals with the same materials would make one of them unreachable
+		key := fmt.Sprint(template.RitualRules.Material)
+		assert.Zero(t, materials[key], "ritual cards %d and %d have the same materials", materials[key], id)
+		materials[key] = id
+	}
+	assert.Equal(t, 24, rituals)
+}
+
+// returns a started game in the place phase with the Gate Guardian materials on playerA side
+func newGameWithGateGuardianMaterials(t *testing.T) (*Game, *CardInstance) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	game := newGameInActionPhase()
+	game.CurrentTurn.Phase = PlaceCardsPhase
+	for position, templateID := range []int{371, 372, 373} { // Sanga, Kazejin, Suijin
+		card, err := NewCardInstance(templateID)
+		assert.NoError(t, err)
+		placeMonster(game, PLAYER_A, position, &CardState{Card: card, FaceUp: true})
+	}
+
+	ritual, err := NewCardInstance(667) // Gate Guardian Ritual
+	assert.NoError(t, err)
+	game.Decks[PLAYER_A].HandCards = append(game.Decks[PLAYER_A].HandCards, ritual)
+	return game, ritual
+}
+
+func TestActivateRitual(t *testing.T) {
+	game, ritual := newGameWithGateGuardianMaterials(t)
+	deck := game.Decks[PLAYER_A]
+
+	err := game.ActivateRitual(ritual, 1)
+	assert.NoError(t, err)
+
+	gateGuardian := game.Board.MonsterZones[PLAYER_A][1]
+	assert.NotNil(t, gateGuardian)
+	assert.Equal(t, 374, gateGuardian.Card.Template.ID)
+	assert.True(t, gateGuardian.FaceUp)
+	assert.Nil(t, game.Board.MonsterZones[PLAYER_A][0])
+	assert.Nil(t, game.Board.MonsterZones[PLAYER_A][2])
+	assert.Equal(t, []*CardInstance{gateGuardian.Card}, deck.ActiveCardsOnBoard)
+	assert.Empty(t, deck.HandCards)
+	assert.Equal(t, 4, len(deck.DestroyedCards), "three materials and the ritual card")
+	assert.Equal(t, ritual, deck.DestroyedCards[3])
+}
+
+func TestEventSacrificeCardsForRitualKeepsBeforeAndAfterState(t *testing.T) {
+	game, ritual := newGameWithGateGuardianMaterials(t)
+	sanga := game.Board.MonsterZones[PLAYER_A][0].Card
+	sanga.CurrentAttack = 2600
+	sanga.CurrentDefense = 2200
+
+	payload := &SacrificeCardsForRitualPayload{PlayerIndex: PLAYER_A, RitualID: ritual.Template.ID, Position: 4}
+	event, _ := NewEvent(payload)
+	assert.NoError(t, game.dispatch(event))
+
+	expectedSanga := &CardView{TemplateID: 371, Name: sanga.Template.Name, FaceUp: true, CurrentAttack: 2600, CurrentDefense: 2200}
+	assert.Equal(t, expectedSanga, payload.Before[0])
+	assert.Nil(t, payload.Before[4])
+	after := payload.After
+	assert.Nil(t, after[0])
+	assert.Nil(t, after[1])
+	assert.Nil(t, after[2])
+	assert.Equal(t, 374, after[4].TemplateID)
+	assert.True(t, after[4].FaceUp)
+
+	// what happens to the cards later does not change what was recorded
+	sanga.CurrentAttack = 0
+	gateGuardian := game.Board.MonsterZones[PLAYER_A][4]
+	gateGuardian.FaceUp = false
+	gateGuardian.Card.CurrentAttack += 1000
+	assert.Equal(t, expectedSanga, payload.Before[0])
+	assert.True(t, after[4].FaceUp)
+	assert.Equal(t, gateGuardian.Card.Template.BaseAttack, after[4].CurrentAttack)
+}
+
+func TestActivateRitualWithInvalidState(t *testing.T) {
+	game, ritual := newGameWithGateGuardianMaterials(t)
+	other, _ := NewCardInstance(38) // Gaia the Fierce Knight
+	placeMonster(game, PLAYER_A, 3, &CardState{Card: other, FaceUp: true})
+
+	err := game.ActivateRitual(other, 4)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is not a ritual card")
+
+	err = game.ActivateRitual(ritual, 5)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid card index position")
+
+	err = game.ActivateRitual(ritual, 3)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is taken by a monster that is not a ritual material")
+
+	game.destroyMonster(PLAYER_A, 2)
+	err = game.ActivateRitual(ritual, 4)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "ritual materials missing on the board")
+
+	game.CurrentTurn.Phase = ActionPhase
+	err = game.ActivateRitual(ritual, 4)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot activate a ritual in the current turn phase")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
@@ -0,0 +1,64 @@
+package models
+
+import "fmt"
+
+type SacrificeCardsForRitualPayload struct {
+	PlayerIndex int
+	RitualID    int // ritual card in the hand of the player
+	Position    int
+	Before      [5]*CardView // monster zones of the player before the ritual, filled in once resolved
+	After       [5]*CardView // monster zones of the player after the ritual, filled in once resolved
+}
+
+func (*SacrificeCardsForRitualPayload) EventType() EventType { return EventSacrificeCardsForRitual }
+func (p *SacrificeCardsForRitualPayload) player() int        { return p.PlayerIndex }
+
+func EventSacrificeCardsForRitualFn(game *Game, payload *SacrificeCardsForRitualPayload) error {
+	if err := game.checkCardPlay(payload.PlayerIndex, "activate a ritual"); err != nil {
+		return err
+	}
+	playerIndex := payload.PlayerIndex
+	deck := game.Decks[playerIndex]
+	ritual, err := deck.GetHandCard(payload.RitualID)
+	if err != nil {
+		return err
+	}
+	materials, err := game.findRitualMaterials(ritual, playerIndex, payload.Position)
+	if err != nil {
+		return err
+	}
+	result, err := NewCardInstance(ritual.Template.RitualRules.ResultID)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Sacrificing monsters to summon %s...\n", result.Template.Name)
+	payload.Before = newZoneView(game.Board.MonsterZones[playerIndex])
+	for _, materialPosition := range materials {
+		game.destroyMonster(playerIndex, materialPosition)
+	}
+
+	result.IsInAttackMode = true
+	state := &CardState{Card: result, FaceUp: true, IndexPosition: payload.Position}
+	if err := game.Board.SetCardAtIndexPosition(state, playerIndex); err != nil {
+		return err
+	}
+	deck.ActiveCardsOnBoard = append(deck.ActiveCardsOnBoard, result)
+	deck.DestroyCard(ritual)
+	payload.After = newZoneView(game.Board.MonsterZones[playerIndex])
+	game.CurrentTurn.CardPlaced = true
+	return game.fireTraps(TriggerMonsterSummoned, (playerIndex+1)%2, payload.Position)
+}
+
+// the opponent does not learn which face-down monsters were around the sacrificed ones
+func (p *SacrificeCardsForRitualPayload) redactFor(playerIndex int) Payload {
+	if playerIndex == p.PlayerIndex {
+		return p
+	}
+	redacted := *p
+	for position := range 5 {
+		redacted.Before[position] = hideFaceDown(p.Before[position])
+		redacted.After[position] = hideFaceDown(p.After[position])
+	}
+	return &redacted
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvalidEventSacrificeCardsForRitualFn(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	game := newGameInActionPhase()
+	game.CurrentTurn.Phase = PlaceCardsPhase
+
+	// Gate Guardian Ritual
+	payload := &SacrificeCardsForRitualPayload{PlayerIndex: PLAYER_A, RitualID: 667, Position: 0}
+	err := EventSacrificeCardsForRitualFn(game, payload)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is not in the hand")
+
+	game.Decks[PLAYER_A].HandCards = newHand(t, 667)
+	err = EventSacrificeCardsForRitualFn(game, payload)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "ritual materials missing on the board")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+func TestSacrificeCardsForRitualPayloadHidesFaceDownMonsters(t *testing.T) {
+	game, ritual := newGameWithGateGuardianMaterials(t)
+	hidden, _ := NewCardInstance(38) // Gaia the Fierce Knight
+	placeMonster(game, PLAYER_A, 3, &CardState{Card: hidden})
+
+	event, _ := NewEvent(&SacrificeCardsForRitualPayload{PlayerIndex: PLAYER_A, RitualID: ritual.Template.ID, Position: 4})
+	assert.NoError(t, game.dispatch(event))
+
+	own := event.ViewFor(PLAYER_A).Payload.(*SacrificeCardsForRitualPayload)
+	assert.Equal(t, 38, own.Before[3].TemplateID)
+
+	opponent := event.ViewFor(PLAYER_B).Payload.(*SacrificeCardsForRitualPayload)
+	assert.Equal(t, &CardView{Hidden: true}, opponent.Before[3])
+	assert.Equal(t, &CardView{Hidden: true}, opponent.After[3])
+	assert.Equal(t, 371, opponent.Before[0].TemplateID, "face-up materials are public")
+	assert.Equal(t, 374, opponent.After[4].TemplateID)
+	assert.Equal(t, 38, event.Payload.(*SacrificeCardsForRitualPayload).Before[3].TemplateID, "the original event is untouched")
+}
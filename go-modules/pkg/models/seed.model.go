@@ -0,0 +1,39 @@
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+)
+
+// the seed of a P2P duel is agreed with a commit-reveal protocol so no peer can rig the deck order:
+//  1. each peer creates a secret with NewSeedSecret and sends CommitSeed(secret) to the other peer
+//  2. once both commitments are received, each peer reveals its secret
+//  3. both peers call RevealSeed and start the game with the same seed
+
+// the source of the secrets, replaced in the tests to simulate a failure
+var readRandom = rand.Read
+
+// returns 32 random bytes, used both as peer secret and as default game seed
+func NewSeedSecret() ([32]byte, error) {
+	var secret [32]byte
+	if _, err := readRandom(secret[:]); err != nil {
+		return [32]byte{}, fmt.Errorf("cannot create the seed secret: %w", err)
+	}
+	return secret, nil
+}
+
+// returns the commitment a peer publishes before revealing its secret
+func CommitSeed(secret [32]byte) [32]byte {
+	return sha256.Sum256(secret[:])
+}
+
+// checks every revealed secret against its commitment and mixes them into the seed of the game
+func RevealSeed(commitments, secrets [2][32]byte) ([32]byte, error) {
+	for peer := range secrets {
+		if CommitSeed(secrets[peer]) != commitments[peer] {
+			return [32]byte{}, fmt.Errorf("the secret of peer %d does not match its commitment", peer)
+		}
+	}
+	return sha256.Sum256(append(secrets[0][:], secrets[1][:]...)), nil
+}
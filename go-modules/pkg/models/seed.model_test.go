@@ -0,0 +1,110 @@
+package models
+
+import (
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommitRevealSeed(t *testing.T) {
+	secretA, err := NewSeedSecret()
+	assert.NoError(t, err)
+	secretB, err := NewSeedSecret()
+	assert.NoError(t, err)
+	secrets := [2][32]byte{secretA, secretB}
+	commitments := [2][32]byte{CommitSeed(secrets[0]), CommitSeed(secrets[1])}
+	assert.NotEqual(t, secrets[0], secrets[1])
+
+	seed, err := RevealSeed(commitments, secrets)
+	assert.NoError(t, err)
+	assert.NotEqual(t, [32]byte{}, seed)
+
+	// both peers get the same seed no matter who reveals first
+	again, _ := RevealSeed(commitments, secrets)
+	assert.Equal(t, seed, again)
+
+	// a peer cannot change its secret after seeing the other one
+	secrets[1][0]++
+	_, err = RevealSeed(commitments, secrets)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the secret of peer 1 does not match its commitment")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+func TestNewSeedSecretWithoutRandomness(t *testing.T) {
+	readRandom = func([]byte) (int, error) { return 0, errors.New("no entropy") }
+	defer func() { readRandom = rand.Read }()
+
+	_, err := NewSeedSecret()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot create the seed secret: no entropy")
+
+	playerA, _ := NewPlayer("PlayerA")
+	playerB, _ := NewPlayer("PlayerB")
+	deckA, _ := NewDeck(playerA, [40]*CardInstance{})
+	deckB, _ := NewDeck(playerB, [40]*CardInstance{})
+	_, err = NewGame([2]*Deck{deckA, deckB})
+	assert.Error(t, err)
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+func TestSeededShuffleIsReproducible(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+
+	newSeededGame := func(seed [32]byte) *Game {
+		cards := [2][40]*CardInstance{}
+		for playerIndex := range cards {
+			for index := range cards[playerIndex] {
+				cards[playerIndex][index], _ = NewCardInstance(index + 1)
+			}
+		}
+		playerA, _ := NewPlayer("PlayerA")
+		playerB, _ := NewPlayer("PlayerB")
+		deckA, _ := NewDeck(playerA, cards[PLAYER_A])
+		deckB, _ := NewDeck(playerB, cards[PLAYER_B])
+		game, _ := NewGame([2]*Deck{deckA, deckB})
+		assert.NoError(t, game.SetSeed(seed))
+		assert.NoError(t, game.Start())
+		return game
+	}
+
+	seed := [32]byte{1, 2, 3}
+	first := newSeededGame(seed)
+	second := newSeededGame(seed)
+	other := newSeededGame([32]byte{4, 5, 6})
+
+	firstOrder := templateIDs(first.Decks[PLAYER_A].RemainingCards)
+	assert.Equal(t, firstOrder, templateIDs(second.Decks[PLAYER_A].RemainingCards))
+	assert.Equal(t, templateIDs(first.Decks[PLAYER_B].RemainingCards), templateIDs(second.Decks[PLAYER_B].RemainingCards))
+	assert.NotEqual(t, firstOrder, templateIDs(other.Decks[PLAYER_A].RemainingCards))
+	assert.NotEqual(t, firstOrder, templateIDs(first.Decks[PLAYER_B].RemainingCards), "each deck gets its own shuffle")
+
+	// the replay shuffles the decks the same way
+	replayed, err := Replay(first.EventLog())
+	assert.NoError(t, err)
+	assert.Equal(t, firstOrder, templateIDs(replayed.Decks[PLAYER_A].RemainingCards))
+
+	err = first.SetSeed(seed)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the seed can only be set in the READY_TO_START state")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+func templateIDs(cards []*CardInstance) []int {
+	ids := make([]int, len(cards))
+	for index, card := range cards {
+		if card != nil {
+			ids[index] = card.Template.ID
+		}
+	}
+	return ids
+}
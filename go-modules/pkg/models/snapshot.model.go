@@ -0,0 +1,329 @@
+package models
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// increase it whenever the snapshot format changes so old snapshots are not misread
+const SnapshotVersion = 2
+
+// reference stored for empty card slots, the card of such slot is nil
+const NoCard = -1
+
+// serializable state of a game, cards are stored once and referenced by their index in Cards
+type Snapshot struct {
+	Version      int
+	ID           string
+	State        GameState
+	StartTime    time.Time
+	DuelDuration time.Duration
+	WinnerIndex  int
+	WinReason    WinReason
+	TimeControl  TimeControl
+	Timeouts     [2]int
+	ClockSpent   [2]time.Duration // time of the finished turns of each player
+	TurnStarted  time.Time        // when the clock of the turn in progress started, zero before the game starts
+	Seed         [32]byte
+	RandomState  []byte // state of the random generator after the last shuffle
+	Players      [2]Player
+	Cards        []CardSnapshot
+	Decks        [2]DeckSnapshot
+	Board        BoardSnapshot
+	Turn         TurnSnapshot
+}
+
+type CardSnapshot struct {
+	TemplateID     int
+	IsInAttackMode bool
+	CurrentAttack  int
+	CurrentDefense int
+}
+
+type DeckSnapshot struct {
+	DeckType           *DeckType
+	RemainingCards     []int
+	HandCards          []int
+	ActiveCardsOnBoard []int
+	DestroyedCards     []int
+}
+
+// one occupied slot of the board
+type SlotSnapshot struct {
+	Zone           Zone
+	PlayerIndex    int
+	Position       int
+	Card           int
+	FaceUp         bool
+	GuardianStar   GuardianStar
+	TerrainAttack  int
+	TerrainDefense int
+}
+
+type BoardSnapshot struct {
+	Terrain Terrain
+	Slots   []SlotSnapshot
+}
+
+type TurnSnapshot struct {
+	PlayerIndex     int
+	Phase           TurnPhase
+	PositionChanged [5]bool
+	Attacked        [5]bool
+	CardPlaced      bool
+}
+
+// captures the state of the game, the events are not processed while it is taken
+func (g *Game) Snapshot() (*Snapshot, error) {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+	return g.snapshot()
+}
+
+func (g *Game) snapshot() (*Snapshot, error) {
+	snapshot := &Snapshot{
+		Version:      SnapshotVersion,
+		ID:           g.ID,
+		State:        g.State,
+		StartTime:    g.StartTime,
+		DuelDuration: g.DuelDuration,
+		WinnerIndex:  g.WinnerIndex,
+		WinReason:    g.WinReason,
+		TimeControl:  g.TimeControl,
+		Timeouts:     g.Timeouts,
+		ClockSpent:   g.clocks.spent,
+		TurnStarted:  g.clocks.startedAt,
+		Seed:         g.Seed,
+		Board:        BoardSnapshot{Terrain: g.Board.Terrain, Slots: []SlotSnapshot{}},
+		Turn: TurnSnapshot{
+			PlayerIndex:     g.CurrentTurn.PlayerIndex,
+			Phase:           g.CurrentTurn.Phase,
+			PositionChanged: g.CurrentTurn.PositionChanged,
+			Attacked:        g.CurrentTurn.Attacked,
+			CardPlaced:      g.CurrentTurn.CardPlaced,
+		},
+	}
+	if g.source != nil {
+		randomState, err := g.source.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		snapshot.RandomState = randomState
+	}
+
+	// the same card can be in a deck list and on the board, it is stored only once
+	indexes := map[*CardInstance]int{}
+	refs := func(cards []*CardInstance) []int {
+		ids := make([]int, len(cards))
+		for index, card := range cards {
+			ids[index] = snapshot.addCard(indexes, card)
+		}
+		return ids
+	}
+	for playerIndex, deck := range g.Decks {
+		snapshot.Players[playerIndex] = *deck.Player
+		snapshot.Decks[playerIndex] = DeckSnapshot{
+			DeckType:           deck.DeckType,
+			RemainingCards:     refs(deck.RemainingCards),
+			HandCards:          refs(deck.HandCards),
+			ActiveCardsOnBoard: refs(deck.ActiveCardsOnBoard),
+			DestroyedCards:     refs(deck.DestroyedCards),
+		}
+	}
+
+	addSlot := func(zone Zone, playerIndex, position int, state *CardState) {
+		if state == nil {
+			return
+		}
+		snapshot.Board.Slots = append(snapshot.Board.Slots, SlotSnapshot{
+			Zone:           zone,
+			PlayerIndex:    playerIndex,
+			Position:       position,
+			Card:           snapshot.addCard(indexes, state.Card),
+			FaceUp:         state.FaceUp,
+			GuardianStar:   state.GuardianStar,
+			TerrainAttack:  state.TerrainAttack,
+			TerrainDefense: state.TerrainDefense,
+		})
+	}
+	for playerIndex := range g.Decks {
+		for position := range 5 {
+			addSlot(ZoneMonster, playerIndex, position, g.Board.MonsterZones[playerIndex][position])
+			addSlot(ZoneMagicTrap, playerIndex, position, g.Board.MagicTrapZones[playerIndex][position])
+		}
+		addSlot(ZoneField, playerIndex, 0, g.Board.FieldZone[playerIndex])
+	}
+	return snapshot, nil
+}
+
+func (s *Snapshot) addCard(indexes map[*CardInstance]int, card *CardInstance) int {
+	if card == nil {
+		return NoCard
+	}
+	if index, exists := indexes[card]; exists {
+		return index
+	}
+	indexes[card] = len(s.Cards)
+	s.Cards = append(s.Cards, CardSnapshot{
+		TemplateID:     card.Template.ID,
+		IsInAttackMode: card.IsInAttackMode,
+		CurrentAttack:  card.CurrentAttack,
+		CurrentDefense: card.CurrentDefense,
+	})
+	return indexes[card]
+}
+
+// rebuilds a game from its snapshot, games in progress start processing events right away
+func RestoreGame(snapshot *Snapshot) (*Game, error) {
+	game, err := restoreGame(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if game.State == GameInProgress {
+		if err := game.run(); err != nil {
+			return nil, err
+		}
+	}
+	return game, nil
+}
+
+// rebuilds the game without running it
+func restoreGame(snapshot *Snapshot) (*Game, error) {
+	if snapshot.Version != SnapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d: expected %d", snapshot.Version, SnapshotVersion)
+	}
+
+	cards := make([]*CardInstance, len(snapshot.Cards))
+	for index, card := range snapshot.Cards {
+		instance, err := NewCardInstance(card.TemplateID)
+		if err != nil {
+			return nil, err
+		}
+		instance.IsInAttackMode = card.IsInAttackMode
+		instance.CurrentAttack = card.CurrentAttack
+		instance.CurrentDefense = card.CurrentDefense
+		cards[index] = instance
+	}
+	card := func(index int) (*CardInstance, error) {
+		if index == NoCard {
+			return nil, nil
+		}
+		if index < 0 || index >= len(cards) {
+			return nil, fmt.Errorf("invalid card reference %d", index)
+		}
+		return cards[index], nil
+	}
+	list := func(indexes []int) ([]*CardInstance, error) {
+		instances := make([]*CardInstance, len(indexes))
+		for position, index := range indexes {
+			instance, err := card(index)
+			if err != nil {
+				return nil, err
+			}
+			instances[position] = instance
+		}
+		return instances, nil
+	}
+
+	game := &Game{
+		ID:           snapshot.ID,
+		Board:        &Board{Terrain: snapshot.Board.Terrain},
+		State:        snapshot.State,
+		StartTime:    snapshot.StartTime,
+		DuelDuration: snapshot.DuelDuration,
+		WinnerIndex:  snapshot.WinnerIndex,
+		WinReason:    snapshot.WinReason,
+		TimeControl:  snapshot.TimeControl,
+		Timeouts:     snapshot.Timeouts,
+		Seed:         snapshot.Seed,
+	}
+	for playerIndex, deckSnapshot := range snapshot.Decks {
+		player := snapshot.Players[playerIndex]
+		deck := &Deck{Player: &player, DeckType: deckSnapshot.DeckType}
+		var err error
+		if deck.RemainingCards, err = list(deckSnapshot.RemainingCards); err != nil {
+			return nil, err
+		}
+		if deck.HandCards, err = list(deckSnapshot.HandCards); err != nil {
+			return nil, err
+		}
+		if deck.ActiveCardsOnBoard, err = list(deckSnapshot.ActiveCardsOnBoard); err != nil {
+			return nil, err
+		}
+		if deck.DestroyedCards, err = list(deckSnapshot.DestroyedCards); err != nil {
+			return nil, err
+		}
+		game.Decks[playerIndex] = deck
+	}
+
+	for _, slot := range snapshot.Board.Slots {
+		if slot.PlayerIndex < 0 || slot.PlayerIndex > 1 || slot.Position < 0 || slot.Position >= 5 {
+			return nil, fmt.Errorf("invalid board slot %d of player %d", slot.Position, slot.PlayerIndex)
+		}
+		instance, err := card(slot.Card)
+		if err != nil {
+			return nil, err
+		}
+		state := &CardState{
+			Card:           instance,
+			FaceUp:         slot.FaceUp,
+			IndexPosition:  slot.Position,
+			GuardianStar:   slot.GuardianStar,
+			TerrainAttack:  slot.TerrainAttack,
+			TerrainDefense: slot.TerrainDefense,
+		}
+		switch slot.Zone {
+		case ZoneMonster:
+			game.Board.MonsterZones[slot.PlayerIndex][slot.Position] = state
+		case ZoneMagicTrap:
+			game.Board.MagicTrapZones[slot.PlayerIndex][slot.Position] = state
+		case ZoneField:
+			game.Board.FieldZone[slot.PlayerIndex] = state
+		default:
+			return nil, fmt.Errorf("invalid zone %q", slot.Zone)
+		}
+	}
+
+	if snapshot.Turn.PlayerIndex < 0 || snapshot.Turn.PlayerIndex > 1 {
+		return nil, fmt.Errorf("invalid turn of player %d: expected 0 or 1", snapshot.Turn.PlayerIndex)
+	}
+	turn, _ := NewTurn(game.Decks[snapshot.Turn.PlayerIndex].Player, snapshot.Turn.PlayerIndex)
+	turn.Phase = snapshot.Turn.Phase
+	turn.PositionChanged = snapshot.Turn.PositionChanged
+	turn.Attacked = snapshot.Turn.Attacked
+	turn.CardPlaced = snapshot.Turn.CardPlaced
+	game.CurrentTurn = turn
+	game.clocks.spent = snapshot.ClockSpent
+	if !snapshot.TurnStarted.IsZero() {
+		game.clocks.turn = turn
+		game.clocks.startedAt = snapshot.TurnStarted
+	}
+
+	game.source = rand.NewChaCha8(game.Seed)
+	if len(snapshot.RandomState) > 0 {
+		if err := game.source.UnmarshalBinary(snapshot.RandomState); err != nil {
+			return nil, err
+		}
+	}
+	game.rng = rand.New(game.source)
+	return game, nil
+}
+
+// same fields without the binary methods, otherwise gob would call them again
+type gobSnapshot Snapshot
+
+// encodes the snapshot in the compact binary form
+func (s *Snapshot) MarshalBinary() ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode((*gobSnapshot)(s)); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func (s *Snapshot) UnmarshalBinary(data []byte) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode((*gobSnapshot)(s))
+}
@@ -0,0 +1,187 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// returns a game in the place phase of playerA with cards in every zone of the board
+func newGameWithCardsEverywhere(t *testing.T) *Game {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+
+	cards := [2][40]*CardInstance{}
+	for playerIndex := range cards {
+		for index := range cards[playerIndex] {
+			cards[playerIndex][index], _ = NewCardInstance(index + 2)
+		}
+	}
+	playerA, _ := NewPlayer("PlayerA")
+	playerB, _ := NewPlayer("PlayerB")
+	deckA, _ := NewDeck(playerA, cards[PLAYER_A])
+	deckB, _ := NewDeck(playerB, cards[PLAYER_B])
+	assert.NoError(t, deckA.SetDeckType(DeckTypeAqua))
+	game, _ := NewGame([2]*Deck{deckA, deckB})
+	assert.NoError(t, game.Start())
+	assert.NoError(t, game.NextPhase())
+
+	// a powered up monster is both in the active cards of its deck and on the board
+	monster := deckA.HandCards[0]
+	deckA.HandCards = deckA.HandCards[1:]
+	monster.IsInAttackMode = false
+	monster.CurrentAttack += 500
+	monster.CurrentDefense += 500
+	placeMonster(game, PLAYER_A, 2, &CardState{Card: monster, FaceUp: true, GuardianStar: GuardianStarMars, TerrainAttack: 500, TerrainDefense: 500})
+	game.Board.MagicTrapZones[PLAYER_B][4] = &CardState{Card: deckB.RemainingCards[0], IndexPosition: 4}
+	game.Board.FieldZone[PLAYER_A] = &CardState{Card: deckA.HandCards[0], FaceUp: true}
+	game.Board.Terrain = TerrainUmi
+	deckB.DestroyCard(deckB.RemainingCards[1])
+	playerB.LifePoints = 7250
+	game.CurrentTurn.PositionChanged[2] = true
+	game.CurrentTurn.Attacked[2] = true
+	return game
+}
+
+func assertSameGame(t *testing.T, expected, actual *Game) {
+	assert.Equal(t, expected.ID, actual.ID)
+	assert.Equal(t, expected.State, actual.State)
+	assert.True(t, expected.StartTime.Equal(actual.StartTime))
+	assert.Equal(t, expected.Seed, actual.Seed)
+	assert.Equal(t, expected.CurrentTurn.PlayerIndex, actual.CurrentTurn.PlayerIndex)
+	assert.Equal(t, expected.CurrentTurn.Phase, actual.CurrentTurn.Phase)
+	assert.Equal(t, expected.CurrentTurn.PositionChanged, actual.CurrentTurn.PositionChanged)
+	assert.Equal(t, expected.CurrentTurn.Attacked, actual.CurrentTurn.Attacked)
+	assert.Equal(t, expected.CurrentTurn.CardPlaced, actual.CurrentTurn.CardPlaced)
+	assert.Equal(t, expected.Board.Terrain, actual.Board.Terrain)
+	for playerIndex := range expected.Decks {
+		assert.Equal(t, expected.Decks[playerIndex].Player.ID, actual.Decks[playerIndex].Player.ID)
+		assert.Equal(t, expected.Decks[playerIndex].Player.LifePoints, actual.Decks[playerIndex].Player.LifePoints)
+		assert.Equal(t, expected.Decks[playerIndex].DeckType, actual.Decks[playerIndex].DeckType)
+		assert.Equal(t, templateIDs(expected.Decks[playerIndex].RemainingCards), templateIDs(actual.Decks[playerIndex].RemainingCards))
+		assert.Equal(t, templateIDs(expected.Decks[playerIndex].HandCards), templateIDs(actual.Decks[playerIndex].HandCards))
+		assert.Equal(t, templateIDs(expected.Decks[playerIndex].ActiveCardsOnBoard), templateIDs(actual.Decks[playerIndex].ActiveCardsOnBoard))
+		assert.Equal(t, templateIDs(expected.Decks[playerIndex].DestroyedCards), templateIDs(actual.Decks[playerIndex].DestroyedCards))
+		for position := range 5 {
+			assert.Equal(t, expected.Board.MonsterZones[playerIndex][position], actual.Board.MonsterZones[playerIndex][position])
+			assert.Equal(t, expected.Board.MagicTrapZones[playerIndex][position], actual.Board.MagicTrapZones[playerIndex][position])
+		}
+		assert.Equal(t, expected.Board.FieldZone[playerIndex], actual.Board.FieldZone[playerIndex])
+	}
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	game := newGameWithCardsEverywhere(t)
+	snapshot, err := game.Snapshot()
+	assert.NoError(t, err)
+	assert.Equal(t, SnapshotVersion, snapshot.Version)
+
+	restored, err := RestoreGame(snapshot)
+	assert.NoError(t, err)
+	assertSameGame(t, game, restored)
+
+	// the restored monster keeps its stats and is still the same card in both places
+	monster := restored.Board.MonsterZones[PLAYER_A][2].Card
+	assert.False(t, monster.IsInAttackMode)
+	assert.Equal(t, game.Board.MonsterZones[PLAYER_A][2].Card.CurrentAttack, monster.CurrentAttack)
+	assert.Same(t, monster, restored.Decks[PLAYER_A].ActiveCardsOnBoard[0])
+
+	// the restored game goes on with the same random sequence
+	assert.NoError(t, restored.NextPhase())
+	for _, current := range []*Game{game, restored} {
+		event, _ := NewEvent(&DeckShuffledPayload{PlayerIndex: PLAYER_B})
+		_, err := current.AddEventAndWait(context.Background(), event)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, templateIDs(game.Decks[PLAYER_B].RemainingCards), templateIDs(restored.Decks[PLAYER_B].RemainingCards))
+	assert.Equal(t, ActionPhase, restored.CurrentTurn.Phase)
+}
+
+func TestSnapshotJSON(t *testing.T) {
+	game := newGameWithCardsEverywhere(t)
+	snapshot, _ := game.Snapshot()
+
+	data, err := json.Marshal(snapshot)
+	assert.NoError(t, err)
+	decoded := &Snapshot{}
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	again, _ := json.Marshal(decoded)
+	assert.JSONEq(t, string(data), string(again))
+
+	restored, err := RestoreGame(decoded)
+	assert.NoError(t, err)
+	assertSameGame(t, game, restored)
+}
+
+func TestSnapshotBinary(t *testing.T) {
+	game := newGameWithCardsEverywhere(t)
+	snapshot, _ := game.Snapshot()
+
+	data, err := snapshot.MarshalBinary()
+	assert.NoError(t, err)
+	decoded := &Snapshot{}
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, snapshot.Cards, decoded.Cards)
+	assert.Equal(t, snapshot.Board, decoded.Board)
+	assert.Equal(t, snapshot.RandomState, decoded.RandomState)
+
+	restored, err := RestoreGame(decoded)
+	assert.NoError(t, err)
+	assertSameGame(t, game, restored)
+
+	err = decoded.UnmarshalBinary([]byte("not a snapshot"))
+	assert.Error(t, err)
+}
+
+func TestSnapshotOfFinishedGame(t *testing.T) {
+	game := newGameWithCardsEverywhere(t)
+	assert.NoError(t, game.Finish())
+	snapshot, _ := game.Snapshot()
+
+	restored, err := RestoreGame(snapshot)
+	assert.NoError(t, err)
+	assert.Equal(t, GameFinished, restored.State)
+	assert.Equal(t, game.DuelDuration, restored.DuelDuration)
+
+	// finished games do not process events anymore
+	event, _ := NewEvent(&DeckShuffledPayload{PlayerIndex: PLAYER_A})
+	assert.Error(t, restored.AddEvent(event))
+}
+
+func TestRestoreGameWithInvalidSnapshot(t *testing.T) {
+	game := newGameWithCardsEverywhere(t)
+
+	snapshot, _ := game.Snapshot()
+	snapshot.Version = SnapshotVersion + 1
+	_, err := RestoreGame(snapshot)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported snapshot version")
+
+	snapshot, _ = game.Snapshot()
+	snapshot.Decks[PLAYER_A].HandCards[0] = len(snapshot.Cards)
+	_, err = RestoreGame(snapshot)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid card reference")
+
+	snapshot, _ = game.Snapshot()
+	snapshot.Board.Slots[0].Position = 5
+	_, err = RestoreGame(snapshot)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid board slot 5 of player 0")
+
+	snapshot, _ = game.Snapshot()
+	snapshot.Board.Slots[0].Zone = "GRAVEYARD"
+	_, err = RestoreGame(snapshot)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid zone")
+
+	snapshot, _ = game.Snapshot()
+	snapshot.Cards[0].TemplateID = 9999
+	_, err = RestoreGame(snapshot)
+	assert.Error(t, err)
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
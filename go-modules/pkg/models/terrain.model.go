@@ -0,0 +1,119 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+)
+
+// represents the land where the duel takes place, field magic cards change it
+type Terrain string
+
+const (
+	TerrainNormal    Terrain = "NORMAL"
+	TerrainForest    Terrain = "FOREST"
+	TerrainWasteland Terrain = "WASTELAND"
+	TerrainMountain  Terrain = "MOUNTAIN"
+	TerrainSogen     Terrain = "SOGEN"
+	TerrainUmi       Terrain = "UMI"
+	TerrainYami      Terrain = "YAMI"
+)
+
+// points added to both attack and defense of the monsters standing on the terrain
+const TerrainBonusPoints = 500
+
+var terrainBonuses = map[Terrain]map[TypeCard]int{
+	TerrainForest: {
+		TypeBeast:        TerrainBonusPoints,
+		TypeBeastWarrior: TerrainBonusPoints,
+		TypeInsect:       TerrainBonusPoints,
+		TypePlant:        TerrainBonusPoints,
+	},
+	TerrainWasteland: {
+		TypeZombie:   TerrainBonusPoints,
+		TypeDinosaur: TerrainBonusPoints,
+		TypeRock:     TerrainBonusPoints,
+	},
+	TerrainMountain: {
+		TypeDragon:      TerrainBonusPoints,
+		TypeWingedBeast: TerrainBonusPoints,
+		TypeThunder:     TerrainBonusPoints,
+	},
+	TerrainSogen: {
+		TypeBeastWarrior: TerrainBonusPoints,
+		TypeWarrior:      TerrainBonusPoints,
+	},
+	TerrainUmi: {
+		TypeAqua:       TerrainBonusPoints,
+		TypeThunder:    TerrainBonusPoints,
+		TypeSeaSerpent: TerrainBonusPoints,
+		TypeFish:       TerrainBonusPoints,
+		TypeMachine:    -TerrainBonusPoints,
+		TypePyro:       -TerrainBonusPoints,
+	},
+	TerrainYami: {
+		TypeFiend:       TerrainBonusPoints,
+		TypeSpellCaster: TerrainBonusPoints,
+		TypeFairy:       -TerrainBonusPoints,
+	},
+}
+
+// returns the points a monster type gains or loses on the terrain
+func TerrainBonus(terrain Terrain, cardType TypeCard) int {
+	return terrainBonuses[terrain][cardType]
+}
+
+// returns the terrain created by a field magic card
+func GetFieldCardTerrain(card *CardInstance) (Terrain, error) {
+	for _, effect := range card.Template.MagicEffects {
+		if effect.Kind == EffectChangeField {
+			return effect.Terrain, nil
+		}
+	}
+	return "", fmt.Errorf("card %q is not a field card", card.Template.Name)
+}
+
+// the points the terrain actually gives are kept on the state, so only those are taken
+// away later even when the monster was weakened in between
+func addTerrainBonus(state *CardState, terrain Terrain) {
+	bonus := TerrainBonus(terrain, state.Card.Template.Type)
+	state.TerrainAttack = addPoints(&state.Card.CurrentAttack, bonus)
+	state.TerrainDefense = addPoints(&state.Card.CurrentDefense, bonus)
+}
+
+func removeTerrainBonus(state *CardState) {
+	addPoints(&state.Card.CurrentAttack, -state.TerrainAttack)
+	addPoints(&state.Card.CurrentDefense, -state.TerrainDefense)
+	state.TerrainAttack = 0
+	state.TerrainDefense = 0
+}
+
+// points never go below 0, returns the change actually made
+func addPoints(points *int, delta int) int {
+	before := *points
+	*points = max(*points+delta, 0)
+	return *points - before
+}
+
+// activates a field magic card of the current player changing the terrain for both players
+func (g *Game) ActivateFieldCard(card *CardInstance) error {
+	return g.play(func() (*Event, error) {
+		return g.fieldCardEvent(card)
+	})
+}
+
+func (g *Game) fieldCardEvent(card *CardInstance) (*Event, error) {
+	if err := g.checkCardPlay(g.CurrentTurn.PlayerIndex, "activate a field card"); err != nil {
+		return nil, err
+	}
+	if _, err := GetFieldCardTerrain(card); err != nil {
+		return nil, err
+	}
+	if !slices.Contains(g.Decks[g.CurrentTurn.PlayerIndex].HandCards, card) {
+		return nil, fmt.Errorf("card %q is not in the hand", card.Template.Name)
+	}
+
+	return NewEvent(&ChangeFieldLandPayload{
+		PlayerIndex: g.CurrentTurn.PlayerIndex,
+		FieldCardID: card.Template.ID,
+	})
+}
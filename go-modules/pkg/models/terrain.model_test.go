@@ -0,0 +1,148 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerrainBonus(t *testing.T) {
+	assert.Equal(t, 500, TerrainBonus(TerrainForest, TypeInsect))
+	assert.Equal(t, 500, TerrainBonus(TerrainMountain, TypeDragon))
+	assert.Equal(t, -500, TerrainBonus(TerrainUmi, TypeMachine))
+	assert.Equal(t, -500, TerrainBonus(TerrainYami, TypeFairy))
+	assert.Equal(t, 0, TerrainBonus(TerrainSogen, TypeDragon))
+	assert.Equal(t, 0, TerrainBonus(TerrainNormal, TypeWarrior))
+}
+
+func TestChangeTerrainRecomputesMonsterPoints(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	board := NewBoard()
+	assert.Equal(t, TerrainNormal, board.Terrain)
+
+	babyDragon, _ := NewCardInstance(4)    // Dragon 1200/700
+	ancientTool, _ := NewCardInstance(124) // Machine 1700/1400
+	board.SetCardAtIndexPosition(&CardState{Card: babyDragon, IndexPosition: 0}, PLAYER_A)
+	board.SetCardAtIndexPosition(&CardState{Card: ancientTool, IndexPosition: 0}, PLAYER_B)
+
+	board.ChangeTerrain(TerrainMountain)
+	assert.Equal(t, 1700, babyDragon.CurrentAttack)
+	assert.Equal(t, 1200, babyDragon.CurrentDefense)
+	assert.Equal(t, 1700, ancientTool.CurrentAttack)
+
+	board.ChangeTerrain(TerrainUmi)
+	assert.Equal(t, 1200, babyDragon.CurrentAttack)
+	assert.Equal(t, 1200, ancientTool.CurrentAttack)
+	assert.Equal(t, 900, ancientTool.CurrentDefense)
+
+	// monsters entering the board get the bonus of the current terrain
+	oscilloHero, _ := NewCardInstance(45) // Thunder 1000/1500
+	board.SetCardAtIndexPosition(&CardState{Card: oscilloHero, IndexPosition: 1}, PLAYER_A)
+	assert.Equal(t, 1500, oscilloHero.CurrentAttack)
+
+	// and lose it when they leave
+	state, err := board.RemoveMonsterAtIndexPosition(PLAYER_A, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, oscilloHero, state.Card)
+	assert.Nil(t, board.MonsterZones[PLAYER_A][1])
+	assert.Equal(t, 1000, oscilloHero.CurrentAttack)
+
+	state, err = board.RemoveMonsterAtIndexPosition(PLAYER_A, 1)
+	assert.NoError(t, err)
+	assert.Nil(t, state)
+}
+
+func TestChangeTerrainAfterTheMonsterWasWeakened(t *testing.T) {
+	game := newGameInActionPhase()
+	beast := &CardInstance{
+		Template:       &CardTemplate{ID: 9000, Type: TypeBeast, GuardianStars: []GuardianStar{GuardianStarSun, GuardianStarMoon}},
+		CurrentAttack:  200,
+		CurrentDefense: 200,
+	}
+	game.Board.ChangeTerrain(TerrainForest)
+	assert.NoError(t, game.Board.SetCardAtIndexPosition(&CardState{Card: beast, IndexPosition: 0}, PLAYER_A))
+	assert.Equal(t, 700, beast.CurrentAttack)
+
+	weaken := &BulkCardPointsUpdatePayload{Positions: []BoardPosition{{PlayerIndex: PLAYER_A, Position: 0}}, Points: -700}
+	assert.NoError(t, EventBulkCardPointsUpdateFn(game, weaken))
+	assert.Equal(t, 0, beast.CurrentAttack)
+
+	// the points never go below 0 when the bonus is taken away
+	game.Board.ChangeTerrain(TerrainNormal)
+	assert.Equal(t, 0, beast.CurrentAttack)
+	assert.Equal(t, 0, beast.CurrentDefense)
+
+	game.Board.ChangeTerrain(TerrainForest)
+	assert.Equal(t, 500, beast.CurrentAttack)
+	game.destroyMonster(PLAYER_A, 0)
+	assert.Equal(t, 0, beast.CurrentAttack)
+
+	// a penalty bigger than the points is given back as it was applied
+	fairy := &CardInstance{
+		Template:       &CardTemplate{ID: 9001, Type: TypeFairy, GuardianStars: []GuardianStar{GuardianStarSun, GuardianStarMoon}},
+		CurrentAttack:  200,
+		CurrentDefense: 800,
+	}
+	game.Board.ChangeTerrain(TerrainYami)
+	assert.NoError(t, game.Board.SetCardAtIndexPosition(&CardState{Card: fairy, IndexPosition: 1}, PLAYER_A))
+	assert.Equal(t, 0, fairy.CurrentAttack)
+	assert.Equal(t, 300, fairy.CurrentDefense)
+	game.Board.ChangeTerrain(TerrainNormal)
+	assert.Equal(t, 200, fairy.CurrentAttack)
+	assert.Equal(t, 800, fairy.CurrentDefense)
+}
+
+func TestActivateFieldCard(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	game := newGameInActionPhase()
+
+	babyDragon, _ := NewCardInstance(4)
+	game.Board.SetCardAtIndexPosition(&CardState{Card: babyDragon, IndexPosition: 0}, PLAYER_B)
+	mountain, _ := NewCardInstance(332)
+	game.Decks[PLAYER_A].HandCards = []*CardInstance{mountain}
+
+	err := game.ActivateFieldCard(mountain)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot activate a field card in the current turn phase")
+
+	game.CurrentTurn.Phase = PlaceCardsPhase
+	err = game.ActivateFieldCard(babyDragon)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is not a field card")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+
+	err = game.ActivateFieldCard(mountain)
+	assert.NoError(t, err)
+
+	assert.Equal(t, TerrainMountain, game.Board.Terrain)
+	assert.Equal(t, mountain, game.Board.FieldZone[PLAYER_A].Card)
+	assert.Empty(t, game.Decks[PLAYER_A].HandCards)
+	assert.Equal(t, []*CardInstance{mountain}, game.Decks[PLAYER_A].ActiveCardsOnBoard)
+	assert.Equal(t, 1700, babyDragon.CurrentAttack)
+
+	// a new field card replaces the previous one in a later turn
+	umi, _ := NewCardInstance(334)
+	game.Decks[PLAYER_A].HandCards = []*CardInstance{umi}
+	err = game.ActivateFieldCard(umi)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "only one card can be placed per turn")
+
+	game.CurrentTurn.CardPlaced = false
+	game.Decks[PLAYER_A].HandCards = nil
+	err = game.ActivateFieldCard(umi)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is not in the hand")
+
+	game.Decks[PLAYER_A].HandCards = []*CardInstance{umi}
+	err = game.ActivateFieldCard(umi)
+	assert.NoError(t, err)
+
+	assert.Equal(t, TerrainUmi, game.Board.Terrain)
+	assert.Equal(t, umi, game.Board.FieldZone[PLAYER_A].Card)
+	assert.Equal(t, []*CardInstance{mountain}, game.Decks[PLAYER_A].DestroyedCards)
+	assert.Equal(t, 1200, babyDragon.CurrentAttack)
+}
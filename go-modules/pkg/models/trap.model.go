@@ -0,0 +1,67 @@
+package models
+
+// represents the game event a face-down trap waits for
+type TrapTrigger string
+
+const (
+	TriggerAttackDeclared  TrapTrigger = "ATTACK_DECLARED"
+	TriggerMonsterSummoned TrapTrigger = "MONSTER_SUMMONED"
+)
+
+// represents what happens to the monster that springs the trap
+type TrapEffect string
+
+const (
+	TrapEffectDestroy TrapEffect = "DESTROY"
+	TrapEffectWeaken  TrapEffect = "WEAKEN"
+)
+
+// defines when a trap card activates and what it does, zero thresholds mean no limit
+type TrapRules struct {
+	Trigger   TrapTrigger `yaml:"trigger"`
+	MaxAttack int         `yaml:"maxAttack"`
+	MaxLevel  int         `yaml:"maxLevel"`
+	Effect    TrapEffect  `yaml:"effect"`
+	Points    int         `yaml:"points"` // attack and defense removed by weakening traps
+}
+
+// checks the trap condition against the monster that triggered it
+func (r *TrapRules) Matches(trigger TrapTrigger, monster *CardInstance) bool {
+	if r.Trigger != trigger {
+		return false
+	}
+	if r.MaxAttack > 0 && monster.CurrentAttack > r.MaxAttack {
+		return false
+	}
+	return r.MaxLevel == 0 || monster.Template.Level <= r.MaxLevel
+}
+
+// activates the first face-down trap of the owner whose condition matches the monster
+// at the given position of the opponent
+func (g *Game) fireTraps(trigger TrapTrigger, trapOwner, monsterPosition int) error {
+	monsterOwner := (trapOwner + 1) % 2
+	monster := g.Board.MonsterZones[monsterOwner][monsterPosition]
+	if monster == nil {
+		return nil
+	}
+
+	for trapPosition, state := range g.Board.MagicTrapZones[trapOwner] {
+		if state == nil || state.FaceUp || state.Card.Template.TrapRules == nil {
+			continue
+		}
+		if !state.Card.Template.TrapRules.Matches(trigger, monster.Card) {
+			continue
+		}
+
+		event, err := NewEvent(&TrapActivatedPayload{
+			PlayerIndex:     trapOwner,
+			TrapPosition:    trapPosition,
+			MonsterPosition: monsterPosition,
+		})
+		if err != nil {
+			return err
+		}
+		return g.dispatch(event)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTrapState(rules *TrapRules) *CardState {
+	return &CardState{Card: &CardInstance{Template: &CardTemplate{Name: "Test Trap", Type: TypeTrap, TrapRules: rules}}}
+}
+
+func TestTrapRulesMatches(t *testing.T) {
+	weakMonster := &CardInstance{Template: &CardTemplate{Level: 2}, CurrentAttack: 900}
+	strongMonster := &CardInstance{Template: &CardTemplate{Level: 7}, CurrentAttack: 2500}
+
+	tests := []struct {
+		name     string
+		rules    *TrapRules
+		trigger  TrapTrigger
+		monster  *CardInstance
+		expected bool
+	}{
+		{"Attack below the threshold", &TrapRules{Trigger: TriggerAttackDeclared, MaxAttack: 1000}, TriggerAttackDeclared, weakMonster, true},
+		{"Attack above the threshold", &TrapRules{Trigger: TriggerAttackDeclared, MaxAttack: 1000}, TriggerAttackDeclared, strongMonster, false},
+		{"Level below the threshold", &TrapRules{Trigger: TriggerAttackDeclared, MaxLevel: 2}, TriggerAttackDeclared, weakMonster, true},
+		{"Level above the threshold", &TrapRules{Trigger: TriggerAttackDeclared, MaxLevel: 2}, TriggerAttackDeclared, strongMonster, false},
+		{"No threshold at all", &TrapRules{Trigger: TriggerAttackDeclared}, TriggerAttackDeclared, strongMonster, true},
+		{"Different trigger", &TrapRules{Trigger: TriggerMonsterSummoned}, TriggerAttackDeclared, weakMonster, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.rules.Matches(tt.trigger, tt.monster))
+		})
+	}
+}
+
+func TestTrapCardsLoadedFromYAML(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+
+	eatgaboon := GetCardRegistry().GetCard(682)
+	assert.Equal(t, &TrapRules{Trigger: TriggerAttackDeclared, MaxAttack: 1000, Effect: TrapEffectDestroy}, eatgaboon.TrapRules)
+
+	// Bad Reaction to Simochi, Reverse Trap and Fake Trap are not supported yet, they never fire
+	withRules := 0
+	for id := 681; id <= 690; id++ {
+		if GetCardRegistry().GetCard(id).TrapRules != nil {
+			withRules++
+		}
+	}
+	assert.Equal(t, 7, withRules)
+}
+
+func TestTrapActivatedWhenAttackIsDeclared(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+
+	t.Run("should destroy the attacker and cancel the battle", func(t *testing.T) {
+		game := newGameInActionPhase()
+		attacker := newMonsterState(900, 0, true)
+		placeMonster(game, PLAYER_A, 0, attacker)
+		eatgaboon, _ := NewCardInstance(682)
+		game.Board.MagicTrapZones[PLAYER_B][1] = &CardState{Card: eatgaboon, IndexPosition: 1}
+
+		event, _ := NewEvent(&MonsterBattlePayload{PlayerIndex: PLAYER_A, AttackerPosition: 0, DefenderPosition: DirectAttack})
+		game.dispatch(event)
+
+		assert.Nil(t, game.Board.MonsterZones[PLAYER_A][0])
+		assert.Equal(t, []*CardInstance{attacker.Card}, game.Decks[PLAYER_A].DestroyedCards)
+		assert.Nil(t, game.Board.MagicTrapZones[PLAYER_B][1])
+		assert.Equal(t, []*CardInstance{eatgaboon}, game.Decks[PLAYER_B].DestroyedCards)
+		assert.Equal(t, 8000, game.Decks[PLAYER_B].Player.LifePoints)
+	})
+
+	t.Run("should stay face-down when the condition does not match", func(t *testing.T) {
+		game := newGameInActionPhase()
+		placeMonster(game, PLAYER_A, 0, newMonsterState(2000, 0, true))
+		eatgaboon, _ := NewCardInstance(682)
+		game.Board.MagicTrapZones[PLAYER_B][1] = &CardState{Card: eatgaboon, IndexPosition: 1}
+
+		event, _ := NewEvent(&MonsterBattlePayload{PlayerIndex: PLAYER_A, AttackerPosition: 0, DefenderPosition: DirectAttack})
+		game.dispatch(event)
+
+		assert.NotNil(t, game.Board.MagicTrapZones[PLAYER_B][1])
+		assert.Equal(t, 6000, game.Decks[PLAYER_B].Player.LifePoints)
+	})
+
+	t.Run("should weaken the attacker before the battle", func(t *testing.T) {
+		game := newGameInActionPhase()
+		attacker := newMonsterState(2000, 1000, true)
+		placeMonster(game, PLAYER_A, 0, attacker)
+		game.Board.MagicTrapZones[PLAYER_B][0] = newTrapState(&TrapRules{Trigger: TriggerAttackDeclared, Effect: TrapEffectWeaken, Points: 700})
+
+		event, _ := NewEvent(&MonsterBattlePayload{PlayerIndex: PLAYER_A, AttackerPosition: 0, DefenderPosition: DirectAttack})
+		game.dispatch(event)
+
+		assert.Equal(t, 1300, attacker.Card.CurrentAttack)
+		assert.Equal(t, 300, attacker.Card.CurrentDefense)
+		assert.Nil(t, game.Board.MagicTrapZones[PLAYER_B][0])
+		assert.Equal(t, 6700, game.Decks[PLAYER_B].Player.LifePoints)
+	})
+}
+
+func TestTrapActivatedWhenMonsterIsSummoned(t *testing.T) {
+	game, ritual := newGameWithGateGuardianMaterials(t)
+	game.Board.MagicTrapZones[PLAYER_B][2] = newTrapState(&TrapRules{Trigger: TriggerMonsterSummoned, Effect: TrapEffectDestroy})
+
+	event, _ := NewEvent(&SacrificeCardsForRitualPayload{PlayerIndex: PLAYER_A, RitualID: ritual.Template.ID, Position: 0})
+	game.dispatch(event)
+
+	assert.Nil(t, game.Board.MonsterZones[PLAYER_A][0], "the ritual monster was destroyed by the trap")
+	assert.Nil(t, game.Board.MagicTrapZones[PLAYER_B][2])
+}
+
+func TestTrapActivatedWhenMonsterIsPlaced(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	game := newGameInActionPhase()
+	game.CurrentTurn.Phase = PlaceCardsPhase
+	game.Decks[PLAYER_A].HandCards = newHand(t, 4, 301) // Baby Dragon and Legendary Sword
+	trap := newTrapState(&TrapRules{Trigger: TriggerMonsterSummoned, Effect: TrapEffectWeaken, Points: 1000})
+	trap.IndexPosition = 3
+	game.Board.MagicTrapZones[PLAYER_B][3] = trap
+
+	event, _ := NewEvent(&CardPlacedPayload{PlayerIndex: PLAYER_A, HandIndexes: []int{0}, Position: 1})
+	assert.NoError(t, game.dispatch(event))
+
+	babyDragon := game.Board.MonsterZones[PLAYER_A][1]
+	assert.Equal(t, 1200-1000, babyDragon.Card.CurrentAttack)
+	assert.Equal(t, 0, babyDragon.Card.CurrentDefense, "points cannot go below zero")
+	assert.Nil(t, game.Board.MagicTrapZones[PLAYER_B][3])
+	assert.Equal(t, []*CardInstance{trap.Card}, game.Decks[PLAYER_B].DestroyedCards)
+
+	// spells do not spring the traps waiting for monsters
+	game.Board.MagicTrapZones[PLAYER_B][3] = newTrapState(&TrapRules{Trigger: TriggerMonsterSummoned, Effect: TrapEffectDestroy})
+	game.CurrentTurn.CardPlaced = false
+	event, _ = NewEvent(&CardPlacedPayload{PlayerIndex: PLAYER_A, HandIndexes: []int{0}, Position: 2})
+	assert.NoError(t, game.dispatch(event))
+	assert.NotNil(t, game.Board.MagicTrapZones[PLAYER_B][3])
+}
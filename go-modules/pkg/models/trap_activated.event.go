@@ -0,0 +1,50 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+type TrapActivatedPayload struct {
+	PlayerIndex     int // owner of the trap
+	TrapPosition    int
+	MonsterPosition int // position of the opponent monster that sprung the trap
+}
+
+func (*TrapActivatedPayload) EventType() EventType { return EventTrapActivated }
+func (p *TrapActivatedPayload) player() int        { return p.PlayerIndex }
+
+func EventTrapActivatedFn(game *Game, payload *TrapActivatedPayload) error {
+	if err := checkBoardPosition(payload.PlayerIndex, payload.TrapPosition); err != nil {
+		return err
+	}
+	trap := game.Board.MagicTrapZones[payload.PlayerIndex][payload.TrapPosition]
+	if trap == nil || trap.Card.Template.TrapRules == nil {
+		return errors.New("trap card missing")
+	}
+	monsterOwner := (payload.PlayerIndex + 1) % 2
+	monster, err := game.Board.GetMonsterAtIndexPosition(monsterOwner, payload.MonsterPosition)
+	if err != nil {
+		return err
+	}
+	if monster == nil {
+		return errors.New("target monster missing")
+	}
+
+	fmt.Printf("Trap %s activated against %s...\n", trap.Card.Template.Name, monster.Card.Template.Name)
+	rules := trap.Card.Template.TrapRules
+	switch rules.Effect {
+	case TrapEffectDestroy:
+		game.destroyMonster(monsterOwner, payload.MonsterPosition)
+	case TrapEffectWeaken:
+		monster.Card.CurrentAttack = max(monster.Card.CurrentAttack-rules.Points, 0)
+		monster.Card.CurrentDefense = max(monster.Card.CurrentDefense-rules.Points, 0)
+	default:
+		return fmt.Errorf("invalid trap effect %q", rules.Effect)
+	}
+
+	// traps are used only once
+	game.Board.MagicTrapZones[payload.PlayerIndex][payload.TrapPosition] = nil
+	game.Decks[payload.PlayerIndex].DestroyCard(trap.Card)
+	return nil
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvalidEventTrapActivatedFn(t *testing.T) {
+	game := newGameInActionPhase()
+	payload := &TrapActivatedPayload{PlayerIndex: PLAYER_B, TrapPosition: 0, MonsterPosition: 0}
+	err := EventTrapActivatedFn(game, payload)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "trap card missing")
+
+	game.Board.MagicTrapZones[PLAYER_B][0] = newTrapState(&TrapRules{Trigger: TriggerAttackDeclared, Effect: "EXPLODE"})
+	err = EventTrapActivatedFn(game, payload)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "target monster missing")
+
+	placeMonster(game, PLAYER_A, 0, newMonsterState(1000, 1000, true))
+	err = EventTrapActivatedFn(game, payload)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid trap effect")
+
+	err = EventTrapActivatedFn(game, &TrapActivatedPayload{PlayerIndex: 2, TrapPosition: 0})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid player index: 2")
+	err = EventTrapActivatedFn(game, &TrapActivatedPayload{PlayerIndex: PLAYER_B, TrapPosition: 0, MonsterPosition: 9})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid card index position: 9")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
@@ -14,10 +14,20 @@ const (
 	EndPhase        TurnPhase = "END_PHASE"
 )
 
+// the phase that follows each phase of a turn, the end phase passes the turn to the opponent
+var nextPhases = map[TurnPhase]TurnPhase{
+	DrawCardsPhase:  PlaceCardsPhase,
+	PlaceCardsPhase: ActionPhase,
+	ActionPhase:     EndPhase,
+}
+
 type Turn struct {
-	CurrentPlayer *Player
-	Phase         TurnPhase
-	PlayerIndex   int
+	CurrentPlayer   *Player
+	Phase           TurnPhase
+	PlayerIndex     int
+	PositionChanged [5]bool // monsters of the player that already changed their position this turn
+	Attacked        [5]bool // monsters of the player that already attacked this turn
+	CardPlaced      bool    // only one card, or the result of one fusion, is played per turn
 }
 
 func NewTurn(player *Player, playerIndex int) (*Turn, error) {
@@ -37,15 +47,10 @@ func NewTurn(player *Player, playerIndex int) (*Turn, error) {
 }
 
 func (t *Turn) NextPhase() error {
-	switch t.Phase {
-	case DrawCardsPhase:
-		t.Phase = PlaceCardsPhase
-	case PlaceCardsPhase:
-		t.Phase = ActionPhase
-	case ActionPhase:
-		t.Phase = EndPhase
-	default:
+	nextPhase, exists := nextPhases[t.Phase]
+	if !exists {
 		return fmt.Errorf("cannot advance to next phase because current phase is %s", t.Phase)
 	}
+	t.Phase = nextPhase
 	return nil
 }
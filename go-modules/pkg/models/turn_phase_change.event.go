@@ -0,0 +1,44 @@
+package models
+
+import "fmt"
+
+type TurnPhaseChangePayload struct {
+	PlayerIndex int // owner of the turn once the change is applied
+	Phase       TurnPhase
+}
+
+func (*TurnPhaseChangePayload) EventType() EventType { return EventTurnPhaseChange }
+func (p *TurnPhaseChangePayload) player() int        { return p.PlayerIndex }
+
+func EventTurnPhaseChangeFn(game *Game, payload *TurnPhaseChangePayload) error {
+	turn := game.CurrentTurn
+	if payload.PlayerIndex == turn.PlayerIndex {
+		if nextPhases[turn.Phase] != payload.Phase {
+			return fmt.Errorf("cannot change from %s to %s", turn.Phase, payload.Phase)
+		}
+		fmt.Printf("Moving to the %s...\n", payload.Phase)
+		return turn.NextPhase()
+	}
+
+	// the turn passes to the opponent only once the current one is over
+	if turn.Phase != EndPhase || payload.Phase != DrawCardsPhase {
+		return fmt.Errorf("cannot pass the turn from %s to the %s of the opponent", turn.Phase, payload.Phase)
+	}
+	if turn.CurrentPlayer.RemainingTurnsToAtack > 0 {
+		turn.CurrentPlayer.RemainingTurnsToAtack -= 1
+	}
+
+	nextTurn, err := NewTurn(game.Decks[payload.PlayerIndex].Player, payload.PlayerIndex)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Passing the turn to %s...\n", nextTurn.CurrentPlayer.Username)
+	game.CurrentTurn = nextTurn
+
+	// entering the draw phase refills the hand of the player
+	draw, err := game.drawEvent(payload.PlayerIndex)
+	if err != nil || draw == nil {
+		return err
+	}
+	return game.dispatch(draw)
+}
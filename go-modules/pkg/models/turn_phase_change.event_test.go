@@ -0,0 +1,37 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventTurnPhaseChangeFnWithSuccess(t *testing.T) {
+	game := newGameInActionPhase()
+	game.CurrentTurn.CurrentPlayer.RemainingTurnsToAtack = 2
+
+	err := EventTurnPhaseChangeFn(game, &TurnPhaseChangePayload{PlayerIndex: PLAYER_A, Phase: EndPhase})
+	assert.NoError(t, err)
+	assert.Equal(t, EndPhase, game.CurrentTurn.Phase)
+
+	err = EventTurnPhaseChangeFn(game, &TurnPhaseChangePayload{PlayerIndex: PLAYER_B, Phase: DrawCardsPhase})
+	assert.NoError(t, err)
+	assert.Equal(t, PLAYER_B, game.CurrentTurn.PlayerIndex)
+	assert.Equal(t, DrawCardsPhase, game.CurrentTurn.Phase)
+	assert.Equal(t, 1, game.Decks[PLAYER_A].Player.RemainingTurnsToAtack)
+}
+
+func TestInvalidEventTurnPhaseChangeFn(t *testing.T) {
+	game := newGameInActionPhase()
+	err := EventTurnPhaseChangeFn(game, &TurnPhaseChangePayload{PlayerIndex: PLAYER_A, Phase: PlaceCardsPhase})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot change from ACTION_PHASE to PLACE_CARDS_PHASE")
+
+	err = EventTurnPhaseChangeFn(game, &TurnPhaseChangePayload{PlayerIndex: PLAYER_B, Phase: DrawCardsPhase})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot pass the turn from ACTION_PHASE")
+	assert.Equal(t, PLAYER_A, game.CurrentTurn.PlayerIndex)
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
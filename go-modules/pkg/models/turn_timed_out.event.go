@@ -0,0 +1,45 @@
+package models
+
+import "fmt"
+
+type TurnTimedOutPayload struct {
+	PlayerIndex int
+	GameClock   bool // the game clock ran out instead of the turn clock, the player loses right away
+}
+
+func (*TurnTimedOutPayload) EventType() EventType { return EventTurnTimedOut }
+func (p *TurnTimedOutPayload) player() int        { return p.PlayerIndex }
+
+func EventTurnTimedOutFn(game *Game, payload *TurnTimedOutPayload) error {
+	if payload.PlayerIndex != game.CurrentTurn.PlayerIndex {
+		return fmt.Errorf("it is not the turn of player %d", payload.PlayerIndex)
+	}
+	player := game.Decks[payload.PlayerIndex].Player
+	opponentIndex := (payload.PlayerIndex + 1) % 2
+	if payload.GameClock {
+		fmt.Printf("%s ran out of time...\n", player.Username)
+		return game.declareWinner(opponentIndex, WinByTimeout)
+	}
+
+	game.Timeouts[payload.PlayerIndex]++
+	fmt.Printf("%s ran out of time for the turn...\n", player.Username)
+	if limit := game.TimeControl.MaxTimeouts; limit > 0 && game.Timeouts[payload.PlayerIndex] >= limit {
+		return game.declareWinner(opponentIndex, WinByForfeit)
+	}
+
+	// the rest of the turn is skipped phase by phase
+	for game.CurrentTurn.Phase != EndPhase {
+		event, err := game.nextPhaseEvent()
+		if err != nil {
+			return err
+		}
+		if err := game.dispatch(event); err != nil {
+			return err
+		}
+	}
+	event, err := game.nextTurnEvent()
+	if err != nil {
+		return err
+	}
+	return game.dispatch(event)
+}
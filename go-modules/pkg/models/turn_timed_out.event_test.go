@@ -0,0 +1,34 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventTurnTimedOutFnWithSuccess(t *testing.T) {
+	game := newGameInActionPhase()
+
+	err := EventTurnTimedOutFn(game, &TurnTimedOutPayload{PlayerIndex: PLAYER_A})
+	assert.NoError(t, err)
+	assert.Equal(t, PLAYER_B, game.CurrentTurn.PlayerIndex)
+	assert.Equal(t, 1, game.Timeouts[PLAYER_A])
+
+	err = EventTurnTimedOutFn(game, &TurnTimedOutPayload{PlayerIndex: PLAYER_B, GameClock: true})
+	assert.NoError(t, err)
+	assert.Equal(t, GameFinished, game.State)
+	assert.Equal(t, PLAYER_A, game.WinnerIndex)
+	assert.Equal(t, WinByTimeout, game.WinReason)
+}
+
+func TestInvalidEventTurnTimedOutFn(t *testing.T) {
+	game := newGameInActionPhase()
+
+	err := EventTurnTimedOutFn(game, &TurnTimedOutPayload{PlayerIndex: PLAYER_B})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "it is not the turn of player 1")
+	assert.Equal(t, 0, game.Timeouts[PLAYER_B])
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
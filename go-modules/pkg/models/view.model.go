@@ -0,0 +1,145 @@
+package models
+
+import "fmt"
+
+// what a player knows about a card, hidden cards only reveal their place on the board
+type CardView struct {
+	Hidden         bool
+	TemplateID     int
+	Name           string
+	FaceUp         bool
+	IsInAttackMode bool
+	CurrentAttack  int
+	CurrentDefense int
+	GuardianStar   GuardianStar
+}
+
+// the order of the remaining cards is secret even for their owner, only the count is shown
+type PlayerView struct {
+	Username              string
+	LifePoints            int
+	RemainingTurnsToAtack int
+	RemainingCount        int
+	HandSize              int
+	Hand                  []CardView // empty for the opponent
+	DestroyedCards        []CardView
+}
+
+type BoardView struct {
+	Terrain        Terrain
+	MonsterZones   [2][5]*CardView
+	MagicTrapZones [2][5]*CardView
+	FieldZone      [2]*CardView
+}
+
+// projection of the game that is safe to send to one of its players
+type GameView struct {
+	ID          string
+	State       GameState
+	PlayerIndex int // the player the view was made for
+	Turn        TurnSnapshot
+	Players     [2]PlayerView
+	Board       BoardView
+}
+
+// payloads carrying information the opponent is not allowed to see return a masked copy
+type redactedPayload interface {
+	redactFor(playerIndex int) Payload
+}
+
+// returns the game as the player sees it: the opponent's hand, the order of the decks and
+// the face-down cards of the opponent are masked, it is safe to call while the game is
+// processing events
+func (g *Game) ViewFor(playerIndex int) (*GameView, error) {
+	if playerIndex < 0 || playerIndex > 1 {
+		return nil, fmt.Errorf("invalid playerIndex %d: expected 0 or 1", playerIndex)
+	}
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	view := &GameView{
+		ID:          g.ID,
+		State:       g.State,
+		PlayerIndex: playerIndex,
+		Turn:        TurnSnapshot{PlayerIndex: g.CurrentTurn.PlayerIndex, Phase: g.CurrentTurn.Phase},
+		Board:       BoardView{Terrain: g.Board.Terrain},
+	}
+	for owner, deck := range g.Decks {
+		player := PlayerView{
+			Username:              deck.Player.Username,
+			LifePoints:            deck.Player.LifePoints,
+			RemainingTurnsToAtack: deck.Player.RemainingTurnsToAtack,
+			RemainingCount:        len(deck.RemainingCards),
+			HandSize:              len(deck.HandCards),
+			Hand:                  []CardView{},
+			DestroyedCards:        []CardView{},
+		}
+		if owner == playerIndex {
+			for _, card := range deck.HandCards {
+				player.Hand = append(player.Hand, newCardView(card))
+			}
+		}
+		for _, card := range deck.DestroyedCards {
+			player.DestroyedCards = append(player.DestroyedCards, newCardView(card))
+		}
+		view.Players[owner] = player
+
+		for position := range 5 {
+			view.Board.MonsterZones[owner][position] = newCardStateView(g.Board.MonsterZones[owner][position], owner == playerIndex)
+			view.Board.MagicTrapZones[owner][position] = newCardStateView(g.Board.MagicTrapZones[owner][position], owner == playerIndex)
+		}
+		view.Board.FieldZone[owner] = newCardStateView(g.Board.FieldZone[owner], owner == playerIndex)
+	}
+	return view, nil
+}
+
+func newCardView(card *CardInstance) CardView {
+	return CardView{
+		TemplateID:     card.Template.ID,
+		Name:           card.Template.Name,
+		IsInAttackMode: card.IsInAttackMode,
+		CurrentAttack:  card.CurrentAttack,
+		CurrentDefense: card.CurrentDefense,
+	}
+}
+
+// the owner knows its face-down cards, the opponent only sees how they were placed
+func newCardStateView(state *CardState, isOwner bool) *CardView {
+	if state == nil {
+		return nil
+	}
+	if !state.FaceUp && !isOwner {
+		return &CardView{Hidden: true, IsInAttackMode: state.Card.IsInAttackMode}
+	}
+	view := newCardView(state.Card)
+	view.FaceUp = state.FaceUp
+	view.GuardianStar = state.GuardianStar
+	return &view
+}
+
+// copies the monster zones as their owner sees them, so later changes on the board do not
+// alter what was recorded
+func newZoneView(zone [5]*CardState) [5]*CardView {
+	var views [5]*CardView
+	for position, state := range zone {
+		views[position] = newCardStateView(state, true)
+	}
+	return views
+}
+
+// the opponent of the owner only sees how a face-down card was placed
+func hideFaceDown(view *CardView) *CardView {
+	if view == nil || view.FaceUp {
+		return view
+	}
+	return &CardView{Hidden: true, IsInAttackMode: view.IsInAttackMode}
+}
+
+// returns the event as it must be broadcast to the player, the original event is left untouched
+func (e *Event) ViewFor(playerIndex int) *Event {
+	view := &Event{Type: e.Type, Timestamp: e.Timestamp, Status: e.Status, Payload: e.Payload, Err: e.Err}
+	if payload, ok := e.Payload.(redactedPayload); ok {
+		view.Payload = payload.redactFor(playerIndex)
+	}
+	return view
+}
@@ -0,0 +1,112 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViewFor(t *testing.T) {
+	game := newGameWithCardsEverywhere(t)
+	deckA := game.Decks[PLAYER_A]
+	deckB := game.Decks[PLAYER_B]
+	deckB.HandCards = newHand(t, 38, 39)
+	hiddenMonster, _ := NewCardInstance(40)
+	hiddenMonster.IsInAttackMode = true
+	placeMonster(game, PLAYER_B, 0, &CardState{Card: hiddenMonster})
+
+	view, err := game.ViewFor(PLAYER_A)
+	assert.NoError(t, err)
+	assert.Equal(t, PLAYER_A, view.PlayerIndex)
+	assert.Equal(t, PlaceCardsPhase, view.Turn.Phase)
+	assert.Equal(t, TerrainUmi, view.Board.Terrain)
+
+	// the own hand is known, the hand of the opponent only by its size
+	assert.Equal(t, len(deckA.HandCards), view.Players[PLAYER_A].HandSize)
+	assert.Equal(t, deckA.HandCards[0].Template.ID, view.Players[PLAYER_A].Hand[0].TemplateID)
+	assert.Equal(t, 2, view.Players[PLAYER_B].HandSize)
+	assert.Empty(t, view.Players[PLAYER_B].Hand)
+	assert.Equal(t, len(deckB.RemainingCards), view.Players[PLAYER_B].RemainingCount)
+	assert.Equal(t, 7250, view.Players[PLAYER_B].LifePoints)
+	assert.Equal(t, deckB.DestroyedCards[0].Template.ID, view.Players[PLAYER_B].DestroyedCards[0].TemplateID)
+
+	// face-down cards of the opponent are placeholders
+	assert.Equal(t, &CardView{Hidden: true, IsInAttackMode: true}, view.Board.MonsterZones[PLAYER_B][0])
+	assert.True(t, view.Board.MagicTrapZones[PLAYER_B][4].Hidden)
+	assert.Zero(t, view.Board.MagicTrapZones[PLAYER_B][4].TemplateID)
+	assert.Nil(t, view.Board.MonsterZones[PLAYER_B][1])
+	monster := view.Board.MonsterZones[PLAYER_A][2]
+	assert.False(t, monster.Hidden)
+	assert.Equal(t, game.Board.MonsterZones[PLAYER_A][2].Card.CurrentAttack, monster.CurrentAttack)
+	assert.Equal(t, GuardianStarMars, monster.GuardianStar)
+
+	// the owner knows its own face-down cards
+	view, _ = game.ViewFor(PLAYER_B)
+	assert.Equal(t, 40, view.Board.MonsterZones[PLAYER_B][0].TemplateID)
+	assert.False(t, view.Board.MonsterZones[PLAYER_B][0].FaceUp)
+	assert.Equal(t, 2, len(view.Players[PLAYER_B].Hand))
+	assert.Empty(t, view.Players[PLAYER_A].Hand)
+	assert.Equal(t, deckA.HandCards[0].Template.ID, view.Board.FieldZone[PLAYER_A].TemplateID)
+
+	_, err = game.ViewFor(2)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid playerIndex 2")
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}
+
+func TestEventViewFor(t *testing.T) {
+	event, _ := NewEvent(&DirectDamageToLifePointsPayload{PlayerIndex: PLAYER_B, Damage: 500})
+	event.Status = SOECompleted
+
+	view := event.ViewFor(PLAYER_A)
+	assert.NotSame(t, event, view)
+	assert.Equal(t, event.Type, view.Type)
+	assert.Equal(t, SOECompleted, view.Status)
+	assert.Same(t, event.Payload, view.Payload, "payloads without hidden information are shared")
+}
+
+func TestEventViewForHidesTheHand(t *testing.T) {
+	placed, _ := NewEvent(&CardPlacedPayload{PlayerIndex: PLAYER_A, HandIndexes: []int{3, 0}, Position: 2, GuardianStar: GuardianStarSun})
+	assert.Same(t, placed.Payload, placed.ViewFor(PLAYER_A).Payload)
+	assert.Equal(t, &CardPlacedPayload{PlayerIndex: PLAYER_A, Position: 2}, placed.ViewFor(PLAYER_B).Payload)
+	assert.Equal(t, []int{3, 0}, placed.Payload.(*CardPlacedPayload).HandIndexes, "the original event is untouched")
+
+	drawn, _ := NewEvent(&CardsDrawnPayload{PlayerIndex: PLAYER_B, Count: 2, CardIDs: []int{4, 38}})
+	assert.Same(t, drawn.Payload, drawn.ViewFor(PLAYER_B).Payload)
+	assert.Equal(t, &CardsDrawnPayload{PlayerIndex: PLAYER_B, Count: 2}, drawn.ViewFor(PLAYER_A).Payload)
+}
+
+func TestEventViewForHidesTheFusions(t *testing.T) {
+	initializeBoardTestSuite()
+	LoadReal722CardsFromYAML()
+	deckA := newDeckOfRealCards("PlayerA")
+	deckA.HandCards = newHand(t, 38, 39, 2, 315) // Gaia the Fierce Knight, Curse of Dragon, Mystical Elf, Dragon Treasure
+	game, _ := NewGame([2]*Deck{deckA, newDeckOfRealCards("PlayerB")})
+
+	reported := []*Event{}
+	game.OnEventProcessed(func(result EventResult) {
+		reported = append(reported, result.Event)
+	})
+	assert.NoError(t, game.Start())
+	assert.NoError(t, game.NextPhase())
+	assert.NoError(t, game.Apply(&PlaceCardAction{PlayerIndex: PLAYER_A, HandIndexes: []int{2, 0, 1, 3}, Position: 2}))
+
+	// Mystical Elf + Gaia fails, Gaia + Curse of Dragon fuses, then the champion is equipped
+	chain := reported[len(reported)-4:]
+	assert.Equal(t, EventCardFusionFailed, chain[1].Type)
+	assert.Equal(t, EventCardFused, chain[2].Type)
+	assert.Equal(t, EventEquipCardAttached, chain[3].Type)
+
+	assert.Equal(t, &CardFusionFailedPayload{PlayerIndex: PLAYER_A, MaterialIDs: [2]int{2, 38}, DiscardedID: 2}, chain[1].ViewFor(PLAYER_A).Payload)
+	assert.Equal(t, &CardFusedPayload{PlayerIndex: PLAYER_A, MaterialIDs: [2]int{38, 39}, ResultID: 37}, chain[2].ViewFor(PLAYER_A).Payload)
+	assert.Equal(t, &EquipCardAttachedPayload{PlayerIndex: PLAYER_A, EquipID: 315, TargetID: 37, Position: InFusionChain}, chain[3].ViewFor(PLAYER_A).Payload)
+
+	// the opponent sees a hidden card at position 2 and learns nothing else about it
+	assert.Equal(t, &CardFusionFailedPayload{PlayerIndex: PLAYER_A}, chain[1].ViewFor(PLAYER_B).Payload)
+	assert.Equal(t, &CardFusedPayload{PlayerIndex: PLAYER_A}, chain[2].ViewFor(PLAYER_B).Payload)
+	assert.Equal(t, &EquipCardAttachedPayload{PlayerIndex: PLAYER_A, Position: InFusionChain}, chain[3].ViewFor(PLAYER_B).Payload)
+	view, _ := game.ViewFor(PLAYER_B)
+	assert.True(t, view.Board.MonsterZones[PLAYER_A][2].Hidden)
+}
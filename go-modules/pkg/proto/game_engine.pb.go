@@ -0,0 +1,1874 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: game_engine.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// hidden cards only tell how they were placed, the rest of their fields are empty
+type Card struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Hidden         bool                   `protobuf:"varint,1,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	TemplateId     int32                  `protobuf:"varint,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	IsInAttackMode bool                   `protobuf:"varint,4,opt,name=is_in_attack_mode,json=isInAttackMode,proto3" json:"is_in_attack_mode,omitempty"`
+	CurrentAttack  int32                  `protobuf:"varint,5,opt,name=current_attack,json=currentAttack,proto3" json:"current_attack,omitempty"`
+	CurrentDefense int32                  `protobuf:"varint,6,opt,name=current_defense,json=currentDefense,proto3" json:"current_defense,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Card) Reset() {
+	*x = Card{}
+	mi := &file_game_engine_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Card) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Card) ProtoMessage() {}
+
+func (x *Card) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Card.ProtoReflect.Descriptor instead.
+func (*Card) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Card) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *Card) GetTemplateId() int32 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *Card) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Card) GetIsInAttackMode() bool {
+	if x != nil {
+		return x.IsInAttackMode
+	}
+	return false
+}
+
+func (x *Card) GetCurrentAttack() int32 {
+	if x != nil {
+		return x.CurrentAttack
+	}
+	return 0
+}
+
+func (x *Card) GetCurrentDefense() int32 {
+	if x != nil {
+		return x.CurrentDefense
+	}
+	return 0
+}
+
+type CardState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	FaceUp        bool                   `protobuf:"varint,2,opt,name=face_up,json=faceUp,proto3" json:"face_up,omitempty"`
+	IndexPosition int32                  `protobuf:"varint,3,opt,name=index_position,json=indexPosition,proto3" json:"index_position,omitempty"`
+	GuardianStar  string                 `protobuf:"bytes,4,opt,name=guardian_star,json=guardianStar,proto3" json:"guardian_star,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardState) Reset() {
+	*x = CardState{}
+	mi := &file_game_engine_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardState) ProtoMessage() {}
+
+func (x *CardState) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardState.ProtoReflect.Descriptor instead.
+func (*CardState) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CardState) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+func (x *CardState) GetFaceUp() bool {
+	if x != nil {
+		return x.FaceUp
+	}
+	return false
+}
+
+func (x *CardState) GetIndexPosition() int32 {
+	if x != nil {
+		return x.IndexPosition
+	}
+	return 0
+}
+
+func (x *CardState) GetGuardianStar() string {
+	if x != nil {
+		return x.GuardianStar
+	}
+	return ""
+}
+
+// only the occupied slots are listed, index_position tells where each card is
+type PlayerBoard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MonsterZone   []*CardState           `protobuf:"bytes,1,rep,name=monster_zone,json=monsterZone,proto3" json:"monster_zone,omitempty"`
+	MagicTrapZone []*CardState           `protobuf:"bytes,2,rep,name=magic_trap_zone,json=magicTrapZone,proto3" json:"magic_trap_zone,omitempty"`
+	FieldZone     *CardState             `protobuf:"bytes,3,opt,name=field_zone,json=fieldZone,proto3" json:"field_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerBoard) Reset() {
+	*x = PlayerBoard{}
+	mi := &file_game_engine_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerBoard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerBoard) ProtoMessage() {}
+
+func (x *PlayerBoard) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerBoard.ProtoReflect.Descriptor instead.
+func (*PlayerBoard) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlayerBoard) GetMonsterZone() []*CardState {
+	if x != nil {
+		return x.MonsterZone
+	}
+	return nil
+}
+
+func (x *PlayerBoard) GetMagicTrapZone() []*CardState {
+	if x != nil {
+		return x.MagicTrapZone
+	}
+	return nil
+}
+
+func (x *PlayerBoard) GetFieldZone() *CardState {
+	if x != nil {
+		return x.FieldZone
+	}
+	return nil
+}
+
+type Board struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Terrain       string                 `protobuf:"bytes,1,opt,name=terrain,proto3" json:"terrain,omitempty"`
+	Players       []*PlayerBoard         `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Board) Reset() {
+	*x = Board{}
+	mi := &file_game_engine_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Board) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Board) ProtoMessage() {}
+
+func (x *Board) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Board.ProtoReflect.Descriptor instead.
+func (*Board) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Board) GetTerrain() string {
+	if x != nil {
+		return x.Terrain
+	}
+	return ""
+}
+
+func (x *Board) GetPlayers() []*PlayerBoard {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type Turn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerIndex   int32                  `protobuf:"varint,1,opt,name=player_index,json=playerIndex,proto3" json:"player_index,omitempty"`
+	Phase         string                 `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Turn) Reset() {
+	*x = Turn{}
+	mi := &file_game_engine_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Turn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Turn) ProtoMessage() {}
+
+func (x *Turn) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Turn.ProtoReflect.Descriptor instead.
+func (*Turn) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Turn) GetPlayerIndex() int32 {
+	if x != nil {
+		return x.PlayerIndex
+	}
+	return 0
+}
+
+func (x *Turn) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+type Player struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Username              string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	LifePoints            int32                  `protobuf:"varint,2,opt,name=life_points,json=lifePoints,proto3" json:"life_points,omitempty"`
+	RemainingTurnsToAtack int32                  `protobuf:"varint,3,opt,name=remaining_turns_to_atack,json=remainingTurnsToAtack,proto3" json:"remaining_turns_to_atack,omitempty"`
+	RemainingCount        int32                  `protobuf:"varint,4,opt,name=remaining_count,json=remainingCount,proto3" json:"remaining_count,omitempty"`
+	HandSize              int32                  `protobuf:"varint,5,opt,name=hand_size,json=handSize,proto3" json:"hand_size,omitempty"`
+	Hand                  []*Card                `protobuf:"bytes,6,rep,name=hand,proto3" json:"hand,omitempty"`
+	DestroyedCards        []*Card                `protobuf:"bytes,7,rep,name=destroyed_cards,json=destroyedCards,proto3" json:"destroyed_cards,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Player) Reset() {
+	*x = Player{}
+	mi := &file_game_engine_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Player) ProtoMessage() {}
+
+func (x *Player) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Player.ProtoReflect.Descriptor instead.
+func (*Player) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Player) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Player) GetLifePoints() int32 {
+	if x != nil {
+		return x.LifePoints
+	}
+	return 0
+}
+
+func (x *Player) GetRemainingTurnsToAtack() int32 {
+	if x != nil {
+		return x.RemainingTurnsToAtack
+	}
+	return 0
+}
+
+func (x *Player) GetRemainingCount() int32 {
+	if x != nil {
+		return x.RemainingCount
+	}
+	return 0
+}
+
+func (x *Player) GetHandSize() int32 {
+	if x != nil {
+		return x.HandSize
+	}
+	return 0
+}
+
+func (x *Player) GetHand() []*Card {
+	if x != nil {
+		return x.Hand
+	}
+	return nil
+}
+
+func (x *Player) GetDestroyedCards() []*Card {
+	if x != nil {
+		return x.DestroyedCards
+	}
+	return nil
+}
+
+// the game as seen by the player with player_index
+type Game struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	PlayerIndex   int32                  `protobuf:"varint,3,opt,name=player_index,json=playerIndex,proto3" json:"player_index,omitempty"`
+	Turn          *Turn                  `protobuf:"bytes,4,opt,name=turn,proto3" json:"turn,omitempty"`
+	Players       []*Player              `protobuf:"bytes,5,rep,name=players,proto3" json:"players,omitempty"`
+	Board         *Board                 `protobuf:"bytes,6,opt,name=board,proto3" json:"board,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Game) Reset() {
+	*x = Game{}
+	mi := &file_game_engine_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Game) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Game) ProtoMessage() {}
+
+func (x *Game) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Game.ProtoReflect.Descriptor instead.
+func (*Game) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Game) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Game) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Game) GetPlayerIndex() int32 {
+	if x != nil {
+		return x.PlayerIndex
+	}
+	return 0
+}
+
+func (x *Game) GetTurn() *Turn {
+	if x != nil {
+		return x.Turn
+	}
+	return nil
+}
+
+func (x *Game) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *Game) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+type Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"` // the typed payload of the event encoded as JSON
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_game_engine_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Event) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Event) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Event) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Event) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Event) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type Deck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	CardIds       []int32                `protobuf:"varint,2,rep,packed,name=card_ids,json=cardIds,proto3" json:"card_ids,omitempty"` // template IDs of the 40 cards
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Deck) Reset() {
+	*x = Deck{}
+	mi := &file_game_engine_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deck) ProtoMessage() {}
+
+func (x *Deck) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deck.ProtoReflect.Descriptor instead.
+func (*Deck) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Deck) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Deck) GetCardIds() []int32 {
+	if x != nil {
+		return x.CardIds
+	}
+	return nil
+}
+
+type CreateGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Decks         []*Deck                `protobuf:"bytes,1,rep,name=decks,proto3" json:"decks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGameRequest) Reset() {
+	*x = CreateGameRequest{}
+	mi := &file_game_engine_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameRequest) ProtoMessage() {}
+
+func (x *CreateGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameRequest.ProtoReflect.Descriptor instead.
+func (*CreateGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateGameRequest) GetDecks() []*Deck {
+	if x != nil {
+		return x.Decks
+	}
+	return nil
+}
+
+// each player gets its own token, in the order of the decks, the requests made on behalf
+// of a player must carry it
+type CreateGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerTokens  []string               `protobuf:"bytes,2,rep,name=player_tokens,json=playerTokens,proto3" json:"player_tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGameResponse) Reset() {
+	*x = CreateGameResponse{}
+	mi := &file_game_engine_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameResponse) ProtoMessage() {}
+
+func (x *CreateGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameResponse.ProtoReflect.Descriptor instead.
+func (*CreateGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateGameResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *CreateGameResponse) GetPlayerTokens() []string {
+	if x != nil {
+		return x.PlayerTokens
+	}
+	return nil
+}
+
+type StartGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartGameRequest) Reset() {
+	*x = StartGameRequest{}
+	mi := &file_game_engine_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameRequest) ProtoMessage() {}
+
+func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameRequest.ProtoReflect.Descriptor instead.
+func (*StartGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StartGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type StartGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartGameResponse) Reset() {
+	*x = StartGameResponse{}
+	mi := &file_game_engine_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameResponse) ProtoMessage() {}
+
+func (x *StartGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameResponse.ProtoReflect.Descriptor instead.
+func (*StartGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{12}
+}
+
+type NextPhaseAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextPhaseAction) Reset() {
+	*x = NextPhaseAction{}
+	mi := &file_game_engine_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextPhaseAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextPhaseAction) ProtoMessage() {}
+
+func (x *NextPhaseAction) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextPhaseAction.ProtoReflect.Descriptor instead.
+func (*NextPhaseAction) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{13}
+}
+
+type NextTurnAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextTurnAction) Reset() {
+	*x = NextTurnAction{}
+	mi := &file_game_engine_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextTurnAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextTurnAction) ProtoMessage() {}
+
+func (x *NextTurnAction) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextTurnAction.ProtoReflect.Descriptor instead.
+func (*NextTurnAction) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{14}
+}
+
+// several hand indexes fuse the cards in that order before placing the result face-down,
+// a monster placed on another monster of the player is fused with it, one placement per turn
+type PlaceCardAction struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	HandIndexes    []int32                `protobuf:"varint,1,rep,packed,name=hand_indexes,json=handIndexes,proto3" json:"hand_indexes,omitempty"`
+	Position       int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	IsInAttackMode bool                   `protobuf:"varint,4,opt,name=is_in_attack_mode,json=isInAttackMode,proto3" json:"is_in_attack_mode,omitempty"`
+	GuardianStar   string                 `protobuf:"bytes,5,opt,name=guardian_star,json=guardianStar,proto3" json:"guardian_star,omitempty"` // the first guardian star of the monster when empty
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlaceCardAction) Reset() {
+	*x = PlaceCardAction{}
+	mi := &file_game_engine_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceCardAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceCardAction) ProtoMessage() {}
+
+func (x *PlaceCardAction) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceCardAction.ProtoReflect.Descriptor instead.
+func (*PlaceCardAction) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PlaceCardAction) GetHandIndexes() []int32 {
+	if x != nil {
+		return x.HandIndexes
+	}
+	return nil
+}
+
+func (x *PlaceCardAction) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *PlaceCardAction) GetIsInAttackMode() bool {
+	if x != nil {
+		return x.IsInAttackMode
+	}
+	return false
+}
+
+func (x *PlaceCardAction) GetGuardianStar() string {
+	if x != nil {
+		return x.GuardianStar
+	}
+	return ""
+}
+
+// switches a monster between attack and defense mode, once per monster and turn
+type ChangePositionAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Position      int32                  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePositionAction) Reset() {
+	*x = ChangePositionAction{}
+	mi := &file_game_engine_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePositionAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePositionAction) ProtoMessage() {}
+
+func (x *ChangePositionAction) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePositionAction.ProtoReflect.Descriptor instead.
+func (*ChangePositionAction) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ChangePositionAction) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type AttackAction struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AttackerPosition int32                  `protobuf:"varint,1,opt,name=attacker_position,json=attackerPosition,proto3" json:"attacker_position,omitempty"`
+	DefenderPosition int32                  `protobuf:"varint,2,opt,name=defender_position,json=defenderPosition,proto3" json:"defender_position,omitempty"` // -1 attacks the life points directly
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AttackAction) Reset() {
+	*x = AttackAction{}
+	mi := &file_game_engine_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttackAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttackAction) ProtoMessage() {}
+
+func (x *AttackAction) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttackAction.ProtoReflect.Descriptor instead.
+func (*AttackAction) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AttackAction) GetAttackerPosition() int32 {
+	if x != nil {
+		return x.AttackerPosition
+	}
+	return 0
+}
+
+func (x *AttackAction) GetDefenderPosition() int32 {
+	if x != nil {
+		return x.DefenderPosition
+	}
+	return 0
+}
+
+type ActivateMagicCardAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardId        int32                  `protobuf:"varint,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateMagicCardAction) Reset() {
+	*x = ActivateMagicCardAction{}
+	mi := &file_game_engine_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateMagicCardAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateMagicCardAction) ProtoMessage() {}
+
+func (x *ActivateMagicCardAction) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateMagicCardAction.ProtoReflect.Descriptor instead.
+func (*ActivateMagicCardAction) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ActivateMagicCardAction) GetCardId() int32 {
+	if x != nil {
+		return x.CardId
+	}
+	return 0
+}
+
+type ActivateFieldCardAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardId        int32                  `protobuf:"varint,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateFieldCardAction) Reset() {
+	*x = ActivateFieldCardAction{}
+	mi := &file_game_engine_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateFieldCardAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateFieldCardAction) ProtoMessage() {}
+
+func (x *ActivateFieldCardAction) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateFieldCardAction.ProtoReflect.Descriptor instead.
+func (*ActivateFieldCardAction) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ActivateFieldCardAction) GetCardId() int32 {
+	if x != nil {
+		return x.CardId
+	}
+	return 0
+}
+
+type ActivateRitualAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardId        int32                  `protobuf:"varint,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	Position      int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateRitualAction) Reset() {
+	*x = ActivateRitualAction{}
+	mi := &file_game_engine_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateRitualAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateRitualAction) ProtoMessage() {}
+
+func (x *ActivateRitualAction) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateRitualAction.ProtoReflect.Descriptor instead.
+func (*ActivateRitualAction) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ActivateRitualAction) GetCardId() int32 {
+	if x != nil {
+		return x.CardId
+	}
+	return 0
+}
+
+func (x *ActivateRitualAction) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type EquipCardAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardId        int32                  `protobuf:"varint,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	Position      int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EquipCardAction) Reset() {
+	*x = EquipCardAction{}
+	mi := &file_game_engine_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquipCardAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquipCardAction) ProtoMessage() {}
+
+func (x *EquipCardAction) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquipCardAction.ProtoReflect.Descriptor instead.
+func (*EquipCardAction) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *EquipCardAction) GetCardId() int32 {
+	if x != nil {
+		return x.CardId
+	}
+	return 0
+}
+
+func (x *EquipCardAction) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type SubmitActionRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	GameId      string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerIndex int32                  `protobuf:"varint,2,opt,name=player_index,json=playerIndex,proto3" json:"player_index,omitempty"`
+	PlayerToken string                 `protobuf:"bytes,13,opt,name=player_token,json=playerToken,proto3" json:"player_token,omitempty"`
+	// Types that are valid to be assigned to Action:
+	//
+	//	*SubmitActionRequest_NextPhase
+	//	*SubmitActionRequest_NextTurn
+	//	*SubmitActionRequest_Attack
+	//	*SubmitActionRequest_ActivateMagicCard
+	//	*SubmitActionRequest_ActivateFieldCard
+	//	*SubmitActionRequest_ActivateRitual
+	//	*SubmitActionRequest_EquipCard
+	//	*SubmitActionRequest_PlaceCard
+	//	*SubmitActionRequest_ChangePosition
+	Action        isSubmitActionRequest_Action `protobuf_oneof:"action"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitActionRequest) Reset() {
+	*x = SubmitActionRequest{}
+	mi := &file_game_engine_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitActionRequest) ProtoMessage() {}
+
+func (x *SubmitActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitActionRequest.ProtoReflect.Descriptor instead.
+func (*SubmitActionRequest) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SubmitActionRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SubmitActionRequest) GetPlayerIndex() int32 {
+	if x != nil {
+		return x.PlayerIndex
+	}
+	return 0
+}
+
+func (x *SubmitActionRequest) GetPlayerToken() string {
+	if x != nil {
+		return x.PlayerToken
+	}
+	return ""
+}
+
+func (x *SubmitActionRequest) GetAction() isSubmitActionRequest_Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *SubmitActionRequest) GetNextPhase() *NextPhaseAction {
+	if x != nil {
+		if x, ok := x.Action.(*SubmitActionRequest_NextPhase); ok {
+			return x.NextPhase
+		}
+	}
+	return nil
+}
+
+func (x *SubmitActionRequest) GetNextTurn() *NextTurnAction {
+	if x != nil {
+		if x, ok := x.Action.(*SubmitActionRequest_NextTurn); ok {
+			return x.NextTurn
+		}
+	}
+	return nil
+}
+
+func (x *SubmitActionRequest) GetAttack() *AttackAction {
+	if x != nil {
+		if x, ok := x.Action.(*SubmitActionRequest_Attack); ok {
+			return x.Attack
+		}
+	}
+	return nil
+}
+
+func (x *SubmitActionRequest) GetActivateMagicCard() *ActivateMagicCardAction {
+	if x != nil {
+		if x, ok := x.Action.(*SubmitActionRequest_ActivateMagicCard); ok {
+			return x.ActivateMagicCard
+		}
+	}
+	return nil
+}
+
+func (x *SubmitActionRequest) GetActivateFieldCard() *ActivateFieldCardAction {
+	if x != nil {
+		if x, ok := x.Action.(*SubmitActionRequest_ActivateFieldCard); ok {
+			return x.ActivateFieldCard
+		}
+	}
+	return nil
+}
+
+func (x *SubmitActionRequest) GetActivateRitual() *ActivateRitualAction {
+	if x != nil {
+		if x, ok := x.Action.(*SubmitActionRequest_ActivateRitual); ok {
+			return x.ActivateRitual
+		}
+	}
+	return nil
+}
+
+func (x *SubmitActionRequest) GetEquipCard() *EquipCardAction {
+	if x != nil {
+		if x, ok := x.Action.(*SubmitActionRequest_EquipCard); ok {
+			return x.EquipCard
+		}
+	}
+	return nil
+}
+
+func (x *SubmitActionRequest) GetPlaceCard() *PlaceCardAction {
+	if x != nil {
+		if x, ok := x.Action.(*SubmitActionRequest_PlaceCard); ok {
+			return x.PlaceCard
+		}
+	}
+	return nil
+}
+
+func (x *SubmitActionRequest) GetChangePosition() *ChangePositionAction {
+	if x != nil {
+		if x, ok := x.Action.(*SubmitActionRequest_ChangePosition); ok {
+			return x.ChangePosition
+		}
+	}
+	return nil
+}
+
+type isSubmitActionRequest_Action interface {
+	isSubmitActionRequest_Action()
+}
+
+type SubmitActionRequest_NextPhase struct {
+	NextPhase *NextPhaseAction `protobuf:"bytes,3,opt,name=next_phase,json=nextPhase,proto3,oneof"`
+}
+
+type SubmitActionRequest_NextTurn struct {
+	NextTurn *NextTurnAction `protobuf:"bytes,4,opt,name=next_turn,json=nextTurn,proto3,oneof"`
+}
+
+type SubmitActionRequest_Attack struct {
+	Attack *AttackAction `protobuf:"bytes,5,opt,name=attack,proto3,oneof"`
+}
+
+type SubmitActionRequest_ActivateMagicCard struct {
+	ActivateMagicCard *ActivateMagicCardAction `protobuf:"bytes,6,opt,name=activate_magic_card,json=activateMagicCard,proto3,oneof"`
+}
+
+type SubmitActionRequest_ActivateFieldCard struct {
+	ActivateFieldCard *ActivateFieldCardAction `protobuf:"bytes,7,opt,name=activate_field_card,json=activateFieldCard,proto3,oneof"`
+}
+
+type SubmitActionRequest_ActivateRitual struct {
+	ActivateRitual *ActivateRitualAction `protobuf:"bytes,8,opt,name=activate_ritual,json=activateRitual,proto3,oneof"`
+}
+
+type SubmitActionRequest_EquipCard struct {
+	EquipCard *EquipCardAction `protobuf:"bytes,9,opt,name=equip_card,json=equipCard,proto3,oneof"`
+}
+
+type SubmitActionRequest_PlaceCard struct {
+	PlaceCard *PlaceCardAction `protobuf:"bytes,11,opt,name=place_card,json=placeCard,proto3,oneof"`
+}
+
+type SubmitActionRequest_ChangePosition struct {
+	ChangePosition *ChangePositionAction `protobuf:"bytes,12,opt,name=change_position,json=changePosition,proto3,oneof"`
+}
+
+func (*SubmitActionRequest_NextPhase) isSubmitActionRequest_Action() {}
+
+func (*SubmitActionRequest_NextTurn) isSubmitActionRequest_Action() {}
+
+func (*SubmitActionRequest_Attack) isSubmitActionRequest_Action() {}
+
+func (*SubmitActionRequest_ActivateMagicCard) isSubmitActionRequest_Action() {}
+
+func (*SubmitActionRequest_ActivateFieldCard) isSubmitActionRequest_Action() {}
+
+func (*SubmitActionRequest_ActivateRitual) isSubmitActionRequest_Action() {}
+
+func (*SubmitActionRequest_EquipCard) isSubmitActionRequest_Action() {}
+
+func (*SubmitActionRequest_PlaceCard) isSubmitActionRequest_Action() {}
+
+func (*SubmitActionRequest_ChangePosition) isSubmitActionRequest_Action() {}
+
+type SubmitActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitActionResponse) Reset() {
+	*x = SubmitActionResponse{}
+	mi := &file_game_engine_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitActionResponse) ProtoMessage() {}
+
+func (x *SubmitActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitActionResponse.ProtoReflect.Descriptor instead.
+func (*SubmitActionResponse) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SubmitActionResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type StreamEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerIndex   int32                  `protobuf:"varint,2,opt,name=player_index,json=playerIndex,proto3" json:"player_index,omitempty"`
+	PlayerToken   string                 `protobuf:"bytes,3,opt,name=player_token,json=playerToken,proto3" json:"player_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventsRequest) Reset() {
+	*x = StreamEventsRequest{}
+	mi := &file_game_engine_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest) ProtoMessage() {}
+
+func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StreamEventsRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *StreamEventsRequest) GetPlayerIndex() int32 {
+	if x != nil {
+		return x.PlayerIndex
+	}
+	return 0
+}
+
+func (x *StreamEventsRequest) GetPlayerToken() string {
+	if x != nil {
+		return x.PlayerToken
+	}
+	return ""
+}
+
+type GetGameViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerIndex   int32                  `protobuf:"varint,2,opt,name=player_index,json=playerIndex,proto3" json:"player_index,omitempty"`
+	PlayerToken   string                 `protobuf:"bytes,3,opt,name=player_token,json=playerToken,proto3" json:"player_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameViewRequest) Reset() {
+	*x = GetGameViewRequest{}
+	mi := &file_game_engine_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameViewRequest) ProtoMessage() {}
+
+func (x *GetGameViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_engine_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameViewRequest.ProtoReflect.Descriptor instead.
+func (*GetGameViewRequest) Descriptor() ([]byte, []int) {
+	return file_game_engine_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetGameViewRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GetGameViewRequest) GetPlayerIndex() int32 {
+	if x != nil {
+		return x.PlayerIndex
+	}
+	return 0
+}
+
+func (x *GetGameViewRequest) GetPlayerToken() string {
+	if x != nil {
+		return x.PlayerToken
+	}
+	return ""
+}
+
+var File_game_engine_proto protoreflect.FileDescriptor
+
+const file_game_engine_proto_rawDesc = "" +
+	"\n" +
+	"\x11game_engine.proto\x12\x14forbiddenmemories.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xce\x01\n" +
+	"\x04Card\x12\x16\n" +
+	"\x06hidden\x18\x01 \x01(\bR\x06hidden\x12\x1f\n" +
+	"\vtemplate_id\x18\x02 \x01(\x05R\n" +
+	"templateId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12)\n" +
+	"\x11is_in_attack_mode\x18\x04 \x01(\bR\x0eisInAttackMode\x12%\n" +
+	"\x0ecurrent_attack\x18\x05 \x01(\x05R\rcurrentAttack\x12'\n" +
+	"\x0fcurrent_defense\x18\x06 \x01(\x05R\x0ecurrentDefense\"\xa0\x01\n" +
+	"\tCardState\x12.\n" +
+	"\x04card\x18\x01 \x01(\v2\x1a.forbiddenmemories.v1.CardR\x04card\x12\x17\n" +
+	"\aface_up\x18\x02 \x01(\bR\x06faceUp\x12%\n" +
+	"\x0eindex_position\x18\x03 \x01(\x05R\rindexPosition\x12#\n" +
+	"\rguardian_star\x18\x04 \x01(\tR\fguardianStar\"\xda\x01\n" +
+	"\vPlayerBoard\x12B\n" +
+	"\fmonster_zone\x18\x01 \x03(\v2\x1f.forbiddenmemories.v1.CardStateR\vmonsterZone\x12G\n" +
+	"\x0fmagic_trap_zone\x18\x02 \x03(\v2\x1f.forbiddenmemories.v1.CardStateR\rmagicTrapZone\x12>\n" +
+	"\n" +
+	"field_zone\x18\x03 \x01(\v2\x1f.forbiddenmemories.v1.CardStateR\tfieldZone\"^\n" +
+	"\x05Board\x12\x18\n" +
+	"\aterrain\x18\x01 \x01(\tR\aterrain\x12;\n" +
+	"\aplayers\x18\x02 \x03(\v2!.forbiddenmemories.v1.PlayerBoardR\aplayers\"?\n" +
+	"\x04Turn\x12!\n" +
+	"\fplayer_index\x18\x01 \x01(\x05R\vplayerIndex\x12\x14\n" +
+	"\x05phase\x18\x02 \x01(\tR\x05phase\"\xb9\x02\n" +
+	"\x06Player\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1f\n" +
+	"\vlife_points\x18\x02 \x01(\x05R\n" +
+	"lifePoints\x127\n" +
+	"\x18remaining_turns_to_atack\x18\x03 \x01(\x05R\x15remainingTurnsToAtack\x12'\n" +
+	"\x0fremaining_count\x18\x04 \x01(\x05R\x0eremainingCount\x12\x1b\n" +
+	"\thand_size\x18\x05 \x01(\x05R\bhandSize\x12.\n" +
+	"\x04hand\x18\x06 \x03(\v2\x1a.forbiddenmemories.v1.CardR\x04hand\x12C\n" +
+	"\x0fdestroyed_cards\x18\a \x03(\v2\x1a.forbiddenmemories.v1.CardR\x0edestroyedCards\"\xea\x01\n" +
+	"\x04Game\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12!\n" +
+	"\fplayer_index\x18\x03 \x01(\x05R\vplayerIndex\x12.\n" +
+	"\x04turn\x18\x04 \x01(\v2\x1a.forbiddenmemories.v1.TurnR\x04turn\x126\n" +
+	"\aplayers\x18\x05 \x03(\v2\x1c.forbiddenmemories.v1.PlayerR\aplayers\x121\n" +
+	"\x05board\x18\x06 \x01(\v2\x1b.forbiddenmemories.v1.BoardR\x05board\"\x9d\x01\n" +
+	"\x05Event\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\fR\apayload\"=\n" +
+	"\x04Deck\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x19\n" +
+	"\bcard_ids\x18\x02 \x03(\x05R\acardIds\"E\n" +
+	"\x11CreateGameRequest\x120\n" +
+	"\x05decks\x18\x01 \x03(\v2\x1a.forbiddenmemories.v1.DeckR\x05decks\"R\n" +
+	"\x12CreateGameResponse\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12#\n" +
+	"\rplayer_tokens\x18\x02 \x03(\tR\fplayerTokens\"+\n" +
+	"\x10StartGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\"\x13\n" +
+	"\x11StartGameResponse\"\x11\n" +
+	"\x0fNextPhaseAction\"\x10\n" +
+	"\x0eNextTurnAction\"\xa6\x01\n" +
+	"\x0fPlaceCardAction\x12!\n" +
+	"\fhand_indexes\x18\x01 \x03(\x05R\vhandIndexes\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x12)\n" +
+	"\x11is_in_attack_mode\x18\x04 \x01(\bR\x0eisInAttackMode\x12#\n" +
+	"\rguardian_star\x18\x05 \x01(\tR\fguardianStarJ\x04\b\x03\x10\x04\"2\n" +
+	"\x14ChangePositionAction\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x05R\bposition\"h\n" +
+	"\fAttackAction\x12+\n" +
+	"\x11attacker_position\x18\x01 \x01(\x05R\x10attackerPosition\x12+\n" +
+	"\x11defender_position\x18\x02 \x01(\x05R\x10defenderPosition\"2\n" +
+	"\x17ActivateMagicCardAction\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\x05R\x06cardId\"2\n" +
+	"\x17ActivateFieldCardAction\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\x05R\x06cardId\"K\n" +
+	"\x14ActivateRitualAction\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\x05R\x06cardId\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\"F\n" +
+	"\x0fEquipCardAction\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\x05R\x06cardId\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\"\xcf\x06\n" +
+	"\x13SubmitActionRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12!\n" +
+	"\fplayer_index\x18\x02 \x01(\x05R\vplayerIndex\x12!\n" +
+	"\fplayer_token\x18\r \x01(\tR\vplayerToken\x12F\n" +
+	"\n" +
+	"next_phase\x18\x03 \x01(\v2%.forbiddenmemories.v1.NextPhaseActionH\x00R\tnextPhase\x12C\n" +
+	"\tnext_turn\x18\x04 \x01(\v2$.forbiddenmemories.v1.NextTurnActionH\x00R\bnextTurn\x12<\n" +
+	"\x06attack\x18\x05 \x01(\v2\".forbiddenmemories.v1.AttackActionH\x00R\x06attack\x12_\n" +
+	"\x13activate_magic_card\x18\x06 \x01(\v2-.forbiddenmemories.v1.ActivateMagicCardActionH\x00R\x11activateMagicCard\x12_\n" +
+	"\x13activate_field_card\x18\a \x01(\v2-.forbiddenmemories.v1.ActivateFieldCardActionH\x00R\x11activateFieldCard\x12U\n" +
+	"\x0factivate_ritual\x18\b \x01(\v2*.forbiddenmemories.v1.ActivateRitualActionH\x00R\x0eactivateRitual\x12F\n" +
+	"\n" +
+	"equip_card\x18\t \x01(\v2%.forbiddenmemories.v1.EquipCardActionH\x00R\tequipCard\x12F\n" +
+	"\n" +
+	"place_card\x18\v \x01(\v2%.forbiddenmemories.v1.PlaceCardActionH\x00R\tplaceCard\x12U\n" +
+	"\x0fchange_position\x18\f \x01(\v2*.forbiddenmemories.v1.ChangePositionActionH\x00R\x0echangePositionB\b\n" +
+	"\x06actionJ\x04\b\n" +
+	"\x10\v\"F\n" +
+	"\x14SubmitActionResponse\x12.\n" +
+	"\x04game\x18\x01 \x01(\v2\x1a.forbiddenmemories.v1.GameR\x04game\"t\n" +
+	"\x13StreamEventsRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12!\n" +
+	"\fplayer_index\x18\x02 \x01(\x05R\vplayerIndex\x12!\n" +
+	"\fplayer_token\x18\x03 \x01(\tR\vplayerToken\"s\n" +
+	"\x12GetGameViewRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12!\n" +
+	"\fplayer_index\x18\x02 \x01(\x05R\vplayerIndex\x12!\n" +
+	"\fplayer_token\x18\x03 \x01(\tR\vplayerToken2\xe8\x03\n" +
+	"\x11GameEngineService\x12_\n" +
+	"\n" +
+	"CreateGame\x12'.forbiddenmemories.v1.CreateGameRequest\x1a(.forbiddenmemories.v1.CreateGameResponse\x12\\\n" +
+	"\tStartGame\x12&.forbiddenmemories.v1.StartGameRequest\x1a'.forbiddenmemories.v1.StartGameResponse\x12e\n" +
+	"\fSubmitAction\x12).forbiddenmemories.v1.SubmitActionRequest\x1a*.forbiddenmemories.v1.SubmitActionResponse\x12X\n" +
+	"\fStreamEvents\x12).forbiddenmemories.v1.StreamEventsRequest\x1a\x1b.forbiddenmemories.v1.Event0\x01\x12S\n" +
+	"\vGetGameView\x12(.forbiddenmemories.v1.GetGameViewRequest\x1a\x1a.forbiddenmemories.v1.GameB?Z=github.com/marcodali/forbidden-memories-duel-online/pkg/protob\x06proto3"
+
+var (
+	file_game_engine_proto_rawDescOnce sync.Once
+	file_game_engine_proto_rawDescData []byte
+)
+
+func file_game_engine_proto_rawDescGZIP() []byte {
+	file_game_engine_proto_rawDescOnce.Do(func() {
+		file_game_engine_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_game_engine_proto_rawDesc), len(file_game_engine_proto_rawDesc)))
+	})
+	return file_game_engine_proto_rawDescData
+}
+
+var file_game_engine_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_game_engine_proto_goTypes = []any{
+	(*Card)(nil),                    // 0: forbiddenmemories.v1.Card
+	(*CardState)(nil),               // 1: forbiddenmemories.v1.CardState
+	(*PlayerBoard)(nil),             // 2: forbiddenmemories.v1.PlayerBoard
+	(*Board)(nil),                   // 3: forbiddenmemories.v1.Board
+	(*Turn)(nil),                    // 4: forbiddenmemories.v1.Turn
+	(*Player)(nil),                  // 5: forbiddenmemories.v1.Player
+	(*Game)(nil),                    // 6: forbiddenmemories.v1.Game
+	(*Event)(nil),                   // 7: forbiddenmemories.v1.Event
+	(*Deck)(nil),                    // 8: forbiddenmemories.v1.Deck
+	(*CreateGameRequest)(nil),       // 9: forbiddenmemories.v1.CreateGameRequest
+	(*CreateGameResponse)(nil),      // 10: forbiddenmemories.v1.CreateGameResponse
+	(*StartGameRequest)(nil),        // 11: forbiddenmemories.v1.StartGameRequest
+	(*StartGameResponse)(nil),       // 12: forbiddenmemories.v1.StartGameResponse
+	(*NextPhaseAction)(nil),         // 13: forbiddenmemories.v1.NextPhaseAction
+	(*NextTurnAction)(nil),          // 14: forbiddenmemories.v1.NextTurnAction
+	(*PlaceCardAction)(nil),         // 15: forbiddenmemories.v1.PlaceCardAction
+	(*ChangePositionAction)(nil),    // 16: forbiddenmemories.v1.ChangePositionAction
+	(*AttackAction)(nil),            // 17: forbiddenmemories.v1.AttackAction
+	(*ActivateMagicCardAction)(nil), // 18: forbiddenmemories.v1.ActivateMagicCardAction
+	(*ActivateFieldCardAction)(nil), // 19: forbiddenmemories.v1.ActivateFieldCardAction
+	(*ActivateRitualAction)(nil),    // 20: forbiddenmemories.v1.ActivateRitualAction
+	(*EquipCardAction)(nil),         // 21: forbiddenmemories.v1.EquipCardAction
+	(*SubmitActionRequest)(nil),     // 22: forbiddenmemories.v1.SubmitActionRequest
+	(*SubmitActionResponse)(nil),    // 23: forbiddenmemories.v1.SubmitActionResponse
+	(*StreamEventsRequest)(nil),     // 24: forbiddenmemories.v1.StreamEventsRequest
+	(*GetGameViewRequest)(nil),      // 25: forbiddenmemories.v1.GetGameViewRequest
+	(*timestamppb.Timestamp)(nil),   // 26: google.protobuf.Timestamp
+}
+var file_game_engine_proto_depIdxs = []int32{
+	0,  // 0: forbiddenmemories.v1.CardState.card:type_name -> forbiddenmemories.v1.Card
+	1,  // 1: forbiddenmemories.v1.PlayerBoard.monster_zone:type_name -> forbiddenmemories.v1.CardState
+	1,  // 2: forbiddenmemories.v1.PlayerBoard.magic_trap_zone:type_name -> forbiddenmemories.v1.CardState
+	1,  // 3: forbiddenmemories.v1.PlayerBoard.field_zone:type_name -> forbiddenmemories.v1.CardState
+	2,  // 4: forbiddenmemories.v1.Board.players:type_name -> forbiddenmemories.v1.PlayerBoard
+	0,  // 5: forbiddenmemories.v1.Player.hand:type_name -> forbiddenmemories.v1.Card
+	0,  // 6: forbiddenmemories.v1.Player.destroyed_cards:type_name -> forbiddenmemories.v1.Card
+	4,  // 7: forbiddenmemories.v1.Game.turn:type_name -> forbiddenmemories.v1.Turn
+	5,  // 8: forbiddenmemories.v1.Game.players:type_name -> forbiddenmemories.v1.Player
+	3,  // 9: forbiddenmemories.v1.Game.board:type_name -> forbiddenmemories.v1.Board
+	26, // 10: forbiddenmemories.v1.Event.timestamp:type_name -> google.protobuf.Timestamp
+	8,  // 11: forbiddenmemories.v1.CreateGameRequest.decks:type_name -> forbiddenmemories.v1.Deck
+	13, // 12: forbiddenmemories.v1.SubmitActionRequest.next_phase:type_name -> forbiddenmemories.v1.NextPhaseAction
+	14, // 13: forbiddenmemories.v1.SubmitActionRequest.next_turn:type_name -> forbiddenmemories.v1.NextTurnAction
+	17, // 14: forbiddenmemories.v1.SubmitActionRequest.attack:type_name -> forbiddenmemories.v1.AttackAction
+	18, // 15: forbiddenmemories.v1.SubmitActionRequest.activate_magic_card:type_name -> forbiddenmemories.v1.ActivateMagicCardAction
+	19, // 16: forbiddenmemories.v1.SubmitActionRequest.activate_field_card:type_name -> forbiddenmemories.v1.ActivateFieldCardAction
+	20, // 17: forbiddenmemories.v1.SubmitActionRequest.activate_ritual:type_name -> forbiddenmemories.v1.ActivateRitualAction
+	21, // 18: forbiddenmemories.v1.SubmitActionRequest.equip_card:type_name -> forbiddenmemories.v1.EquipCardAction
+	15, // 19: forbiddenmemories.v1.SubmitActionRequest.place_card:type_name -> forbiddenmemories.v1.PlaceCardAction
+	16, // 20: forbiddenmemories.v1.SubmitActionRequest.change_position:type_name -> forbiddenmemories.v1.ChangePositionAction
+	6,  // 21: forbiddenmemories.v1.SubmitActionResponse.game:type_name -> forbiddenmemories.v1.Game
+	9,  // 22: forbiddenmemories.v1.GameEngineService.CreateGame:input_type -> forbiddenmemories.v1.CreateGameRequest
+	11, // 23: forbiddenmemories.v1.GameEngineService.StartGame:input_type -> forbiddenmemories.v1.StartGameRequest
+	22, // 24: forbiddenmemories.v1.GameEngineService.SubmitAction:input_type -> forbiddenmemories.v1.SubmitActionRequest
+	24, // 25: forbiddenmemories.v1.GameEngineService.StreamEvents:input_type -> forbiddenmemories.v1.StreamEventsRequest
+	25, // 26: forbiddenmemories.v1.GameEngineService.GetGameView:input_type -> forbiddenmemories.v1.GetGameViewRequest
+	10, // 27: forbiddenmemories.v1.GameEngineService.CreateGame:output_type -> forbiddenmemories.v1.CreateGameResponse
+	12, // 28: forbiddenmemories.v1.GameEngineService.StartGame:output_type -> forbiddenmemories.v1.StartGameResponse
+	23, // 29: forbiddenmemories.v1.GameEngineService.SubmitAction:output_type -> forbiddenmemories.v1.SubmitActionResponse
+	7,  // 30: forbiddenmemories.v1.GameEngineService.StreamEvents:output_type -> forbiddenmemories.v1.Event
+	6,  // 31: forbiddenmemories.v1.GameEngineService.GetGameView:output_type -> forbiddenmemories.v1.Game
+	27, // [27:32] is the sub-list for method output_type
+	22, // [22:27] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_game_engine_proto_init() }
+func file_game_engine_proto_init() {
+	if File_game_engine_proto != nil {
+		return
+	}
+	file_game_engine_proto_msgTypes[22].OneofWrappers = []any{
+		(*SubmitActionRequest_NextPhase)(nil),
+		(*SubmitActionRequest_NextTurn)(nil),
+		(*SubmitActionRequest_Attack)(nil),
+		(*SubmitActionRequest_ActivateMagicCard)(nil),
+		(*SubmitActionRequest_ActivateFieldCard)(nil),
+		(*SubmitActionRequest_ActivateRitual)(nil),
+		(*SubmitActionRequest_EquipCard)(nil),
+		(*SubmitActionRequest_PlaceCard)(nil),
+		(*SubmitActionRequest_ChangePosition)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_game_engine_proto_rawDesc), len(file_game_engine_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_game_engine_proto_goTypes,
+		DependencyIndexes: file_game_engine_proto_depIdxs,
+		MessageInfos:      file_game_engine_proto_msgTypes,
+	}.Build()
+	File_game_engine_proto = out.File
+	file_game_engine_proto_goTypes = nil
+	file_game_engine_proto_depIdxs = nil
+}
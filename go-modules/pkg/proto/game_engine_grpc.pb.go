@@ -0,0 +1,281 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: game_engine.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GameEngineService_CreateGame_FullMethodName   = "/forbiddenmemories.v1.GameEngineService/CreateGame"
+	GameEngineService_StartGame_FullMethodName    = "/forbiddenmemories.v1.GameEngineService/StartGame"
+	GameEngineService_SubmitAction_FullMethodName = "/forbiddenmemories.v1.GameEngineService/SubmitAction"
+	GameEngineService_StreamEvents_FullMethodName = "/forbiddenmemories.v1.GameEngineService/StreamEvents"
+	GameEngineService_GetGameView_FullMethodName  = "/forbiddenmemories.v1.GameEngineService/GetGameView"
+)
+
+// GameEngineServiceClient is the client API for GameEngineService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// drives the duels of the game engine, every game is seen through the redacted view of one player
+type GameEngineServiceClient interface {
+	CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*CreateGameResponse, error)
+	StartGame(ctx context.Context, in *StartGameRequest, opts ...grpc.CallOption) (*StartGameResponse, error)
+	SubmitAction(ctx context.Context, in *SubmitActionRequest, opts ...grpc.CallOption) (*SubmitActionResponse, error)
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error)
+	GetGameView(ctx context.Context, in *GetGameViewRequest, opts ...grpc.CallOption) (*Game, error)
+}
+
+type gameEngineServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGameEngineServiceClient(cc grpc.ClientConnInterface) GameEngineServiceClient {
+	return &gameEngineServiceClient{cc}
+}
+
+func (c *gameEngineServiceClient) CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*CreateGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGameResponse)
+	err := c.cc.Invoke(ctx, GameEngineService_CreateGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameEngineServiceClient) StartGame(ctx context.Context, in *StartGameRequest, opts ...grpc.CallOption) (*StartGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartGameResponse)
+	err := c.cc.Invoke(ctx, GameEngineService_StartGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameEngineServiceClient) SubmitAction(ctx context.Context, in *SubmitActionRequest, opts ...grpc.CallOption) (*SubmitActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitActionResponse)
+	err := c.cc.Invoke(ctx, GameEngineService_SubmitAction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameEngineServiceClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GameEngineService_ServiceDesc.Streams[0], GameEngineService_StreamEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamEventsRequest, Event]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameEngineService_StreamEventsClient = grpc.ServerStreamingClient[Event]
+
+func (c *gameEngineServiceClient) GetGameView(ctx context.Context, in *GetGameViewRequest, opts ...grpc.CallOption) (*Game, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Game)
+	err := c.cc.Invoke(ctx, GameEngineService_GetGameView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GameEngineServiceServer is the server API for GameEngineService service.
+// All implementations must embed UnimplementedGameEngineServiceServer
+// for forward compatibility.
+//
+// drives the duels of the game engine, every game is seen through the redacted view of one player
+type GameEngineServiceServer interface {
+	CreateGame(context.Context, *CreateGameRequest) (*CreateGameResponse, error)
+	StartGame(context.Context, *StartGameRequest) (*StartGameResponse, error)
+	SubmitAction(context.Context, *SubmitActionRequest) (*SubmitActionResponse, error)
+	StreamEvents(*StreamEventsRequest, grpc.ServerStreamingServer[Event]) error
+	GetGameView(context.Context, *GetGameViewRequest) (*Game, error)
+	mustEmbedUnimplementedGameEngineServiceServer()
+}
+
+// UnimplementedGameEngineServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGameEngineServiceServer struct{}
+
+func (UnimplementedGameEngineServiceServer) CreateGame(context.Context, *CreateGameRequest) (*CreateGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGame not implemented")
+}
+func (UnimplementedGameEngineServiceServer) StartGame(context.Context, *StartGameRequest) (*StartGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartGame not implemented")
+}
+func (UnimplementedGameEngineServiceServer) SubmitAction(context.Context, *SubmitActionRequest) (*SubmitActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAction not implemented")
+}
+func (UnimplementedGameEngineServiceServer) StreamEvents(*StreamEventsRequest, grpc.ServerStreamingServer[Event]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEvents not implemented")
+}
+func (UnimplementedGameEngineServiceServer) GetGameView(context.Context, *GetGameViewRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameView not implemented")
+}
+func (UnimplementedGameEngineServiceServer) mustEmbedUnimplementedGameEngineServiceServer() {}
+func (UnimplementedGameEngineServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeGameEngineServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GameEngineServiceServer will
+// result in compilation errors.
+type UnsafeGameEngineServiceServer interface {
+	mustEmbedUnimplementedGameEngineServiceServer()
+}
+
+func RegisterGameEngineServiceServer(s grpc.ServiceRegistrar, srv GameEngineServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGameEngineServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GameEngineService_ServiceDesc, srv)
+}
+
+func _GameEngineService_CreateGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameEngineServiceServer).CreateGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameEngineService_CreateGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameEngineServiceServer).CreateGame(ctx, req.(*CreateGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameEngineService_StartGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameEngineServiceServer).StartGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameEngineService_StartGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameEngineServiceServer).StartGame(ctx, req.(*StartGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameEngineService_SubmitAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameEngineServiceServer).SubmitAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameEngineService_SubmitAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameEngineServiceServer).SubmitAction(ctx, req.(*SubmitActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameEngineService_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameEngineServiceServer).StreamEvents(m, &grpc.GenericServerStream[StreamEventsRequest, Event]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameEngineService_StreamEventsServer = grpc.ServerStreamingServer[Event]
+
+func _GameEngineService_GetGameView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameEngineServiceServer).GetGameView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameEngineService_GetGameView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameEngineServiceServer).GetGameView(ctx, req.(*GetGameViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GameEngineService_ServiceDesc is the grpc.ServiceDesc for GameEngineService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GameEngineService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "forbiddenmemories.v1.GameEngineService",
+	HandlerType: (*GameEngineServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGame",
+			Handler:    _GameEngineService_CreateGame_Handler,
+		},
+		{
+			MethodName: "StartGame",
+			Handler:    _GameEngineService_StartGame_Handler,
+		},
+		{
+			MethodName: "SubmitAction",
+			Handler:    _GameEngineService_SubmitAction_Handler,
+		},
+		{
+			MethodName: "GetGameView",
+			Handler:    _GameEngineService_GetGameView_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _GameEngineService_StreamEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "game_engine.proto",
+}
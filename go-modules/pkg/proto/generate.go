@@ -0,0 +1,5 @@
+// Package proto holds the protobuf messages and gRPC services of the game,
+// the code is generated from the .proto files with buf and the protoc-gen-go plugins
+package proto
+
+//go:generate buf generate --template buf.gen.yaml
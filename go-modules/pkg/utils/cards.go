@@ -0,0 +1,59 @@
+package utils
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/marcodali/forbidden-memories-duel-online/pkg/models"
+)
+
+// the canonical 722 cards of the game
+//
+//go:embed cards.yaml
+var cardsYAML []byte
+
+//go:embed fusions.yaml
+var fusionsYAML []byte
+
+var (
+	// the registries already holding the default data, they change when the registries are cleaned
+	cardsLoadedInto    *models.CardRegistry
+	fusionsLoadedInto  *models.FusionRegistry
+	defaultRegistryMux sync.Mutex
+)
+
+// loads the embedded cards and fusions into the global registries, only the first time,
+// then the YAML files given as overrides in their order: each card of a mod pack replaces
+// the card with the same ID or adds a new one
+func LoadDefaultRegistry(overrides ...string) (*models.CardRegistry, error) {
+	defaultRegistryMux.Lock()
+	defer defaultRegistryMux.Unlock()
+
+	registry := models.GetCardRegistry()
+	if cardsLoadedInto != registry {
+		if err := registry.LoadCardsfromYAML(cardsYAML); err != nil {
+			return nil, fmt.Errorf("cannot load the default cards: %w", err)
+		}
+		cardsLoadedInto = registry
+	}
+	fusions := models.GetFusionRegistry()
+	if fusionsLoadedInto != fusions {
+		if err := fusions.LoadFusionsFromYAML(fusionsYAML); err != nil {
+			return nil, fmt.Errorf("cannot load the default fusions: %w", err)
+		}
+		fusionsLoadedInto = fusions
+	}
+
+	for _, path := range overrides {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read the override %s: %w", path, err)
+		}
+		if err := registry.LoadCardsfromYAML(data); err != nil {
+			return nil, fmt.Errorf("cannot load the override %s: %w", path, err)
+		}
+	}
+	return registry, nil
+}
@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/marcodali/forbidden-memories-duel-online/pkg/models"
+)
+
+func writeOverride(t *testing.T, data string) string {
+	path := filepath.Join(t.TempDir(), "override.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(data), 0o644))
+	return path
+}
+
+func TestLoadDefaultRegistry(t *testing.T) {
+	registry, err := LoadDefaultRegistry()
+	assert.NoError(t, err)
+	assert.Same(t, models.GetCardRegistry(), registry)
+	assert.Equal(t, "Magician of Black Chaos", registry.GetCard(722).Name)
+	assert.Equal(t, 722, registry.Query().Count())
+
+	// the fusions are loaded with the cards, and only once
+	gaia, monster := registry.GetCard(38), registry.GetCard(39)
+	assert.Equal(t, 37, models.GetFusionRegistry().GetFusionResult(gaia, monster))
+	_, err = LoadDefaultRegistry()
+	assert.NoError(t, err)
+	assert.Equal(t, 722, registry.Query().Count())
+}
+
+func TestLoadDefaultRegistryWithOverrides(t *testing.T) {
+	modPack := writeOverride(t, `
+- id: 2
+  name: "Mystical Elf EX"
+  baseAttack: 1200
+  baseDefense: 2000
+  level: 4
+  type: "Spellcaster"
+  guardianStars: ["Sun", "Jupiter"]
+  rarity: "RARE"
+- id: 9001
+  name: "Modded Dragon"
+  baseAttack: 2600
+  baseDefense: 2100
+  level: 7
+  type: "Dragon"
+  guardianStars: ["Sun", "Mars"]
+  rarity: "SUPER_RARE"
+`)
+	registry, err := LoadDefaultRegistry(modPack)
+	assert.NoError(t, err)
+	assert.Equal(t, "Mystical Elf EX", registry.GetCard(2).Name)
+	assert.Equal(t, 1200, registry.GetCard(2).BaseAttack)
+	assert.Equal(t, "Modded Dragon", registry.GetCard(9001).Name)
+
+	// the overrides stay in place until the registry is cleaned
+	models.CleanRegistry()
+	registry, err = LoadDefaultRegistry()
+	assert.NoError(t, err)
+	assert.Equal(t, "Mystical Elf", registry.GetCard(2).Name)
+	assert.Nil(t, registry.GetCard(9001))
+}
+
+func TestLoadDefaultRegistryWithInvalidOverrides(t *testing.T) {
+	_, err := LoadDefaultRegistry(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot read the override")
+
+	_, err = LoadDefaultRegistry(writeOverride(t, `
+- id: 9002
+  name: "Dangling Sword"
+  type: "Equip"
+  rarity: "NORMAL"
+  equipRules:
+    validTargetIDs: [9999]
+    bonus: 500
+`))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "card 9002: equip target 9999 does not exist")
+	assert.Nil(t, models.GetCardRegistry().GetCard(9002))
+
+	// to see this error message, run the test with -v flag
+	t.Logf("Error: %v", err)
+}